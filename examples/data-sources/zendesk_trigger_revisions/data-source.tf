@@ -0,0 +1,20 @@
+data "zendesk_trigger_revisions" "route_vip" {
+  trigger_id = zendesk_trigger.route_vip.id
+}
+
+# Who changed the routing of VIP tickets, when, and what they changed
+output "route_vip_history" {
+  value = [
+    for revision in data.zendesk_trigger_revisions.route_vip.revisions : {
+      author_id  = revision.author_id
+      created_at = revision.created_at
+      changes    = [for change in revision.changes : "${change.change} ${change.attribute}: ${change.value}"]
+    }
+  ]
+}
+
+# To roll the trigger back, replace its title, conditions and actions with the revision:
+#
+# resource "zendesk_trigger" "route_vip" {
+#   restore_revision_id = "100"
+# }
@@ -0,0 +1,21 @@
+# Warn when the VIP queue grows too long
+check "vip_queue_length" {
+  data "zendesk_view_count" "vip_queue" {
+    view_id = zendesk_view.vip_queue.id
+  }
+
+  assert {
+    # The value is null while Zendesk is still counting
+    condition     = coalesce(data.zendesk_view_count.vip_queue.value, 0) < 25
+    error_message = "The VIP queue has ${data.zendesk_view_count.vip_queue.pretty} tickets."
+  }
+}
+
+# Count several views in one request
+data "zendesk_view_count" "queues" {
+  view_ids = [zendesk_view.vip_queue.id, "360002440594"]
+}
+
+output "queue_lengths" {
+  value = { for count in data.zendesk_view_count.queues.counts : count.view_id => count.value }
+}
@@ -0,0 +1,14 @@
+# Warn when tickets wait in the VIP queue, naming the oldest of them
+check "vip_queue_empty" {
+  data "zendesk_view_tickets" "vip_queue" {
+    view_id    = zendesk_view.vip_queue.id
+    sort_by    = "created_at"
+    sort_order = "asc"
+    limit      = 5
+  }
+
+  assert {
+    condition     = length(data.zendesk_view_tickets.vip_queue.ticket_ids) == 0
+    error_message = "Tickets wait in the VIP queue, the oldest are ${join(", ", data.zendesk_view_tickets.vip_queue.ticket_ids)}."
+  }
+}
@@ -0,0 +1,13 @@
+# Look up a webhook created outside of Terraform by its name
+data "zendesk_webhook" "by_name" {
+  name = "My Webhook"
+}
+
+# or by its id
+data "zendesk_webhook" "by_id" {
+  webhook_id = "01GE1JZ9V0Y7ZQ6QCJVPBF5E9D"
+}
+
+output "webhook_endpoint" {
+  value = data.zendesk_webhook.by_name.webhook.endpoint
+}
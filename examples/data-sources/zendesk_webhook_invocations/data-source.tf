@@ -0,0 +1,30 @@
+# Warn when the latest deliveries of a webhook failed
+check "my_webhook_deliveries" {
+  data "zendesk_webhook_invocations" "failed" {
+    webhook_id = zendesk_webhook.my_webhook.webhook_id
+    status     = "failed"
+    from_ts    = timeadd(plantimestamp(), "-24h")
+    page_size  = 10
+  }
+
+  assert {
+    condition     = length(data.zendesk_webhook_invocations.failed.invocations) == 0
+    error_message = "The webhook failed to deliver ${length(data.zendesk_webhook_invocations.failed.invocations)} invocations in the last 24 hours."
+  }
+}
+
+# Warn when the endpoint answers slowly
+check "my_webhook_latency" {
+  data "zendesk_webhook_invocations" "latest" {
+    webhook_id = zendesk_webhook.my_webhook.webhook_id
+    page_size  = 20
+  }
+
+  assert {
+    condition = alltrue([
+      for invocation in data.zendesk_webhook_invocations.latest.invocations :
+      try(invocation.attempts[0].latency_ms < 5000, true)
+    ])
+    error_message = "The webhook endpoint took longer than 5 seconds to answer a delivery."
+  }
+}
@@ -0,0 +1,13 @@
+# Read the signing secret of a webhook, e.g. to store it where the receiving service verifies the signatures
+data "zendesk_webhook_signing_secret" "my_webhook" {
+  webhook_id = zendesk_webhook.my_webhook.webhook_id
+}
+
+output "webhook_signing_algorithm" {
+  value = data.zendesk_webhook_signing_secret.my_webhook.signing_secret.algorithm
+}
+
+output "webhook_signing_secret" {
+  value     = data.zendesk_webhook_signing_secret.my_webhook.signing_secret.secret
+  sensitive = true
+}
@@ -0,0 +1,15 @@
+# Test an endpoint before it is used for a webhook, the plan fails when it does not answer with a 2xx status
+data "zendesk_webhook_test" "new_endpoint" {
+  endpoint       = "https://example.com/webhook"
+  http_method    = "POST"
+  request_format = "json"
+  custom_headers = {
+    "X-My-Header" = "My-Value"
+  }
+  payload = jsonencode({ message = "Test request of Terraform" })
+}
+
+# Test an existing webhook with its stored authentication
+data "zendesk_webhook_test" "my_webhook" {
+  webhook_id = zendesk_webhook.my_webhook.webhook_id
+}
@@ -0,0 +1,8 @@
+# The listed SLA policies are matched first, in this order,
+# followed by the other SLA policies of the account.
+resource "zendesk_sla_policy_order" "all" {
+  sla_policy_ids = [
+    zendesk_sla_policy.vip.id,
+    zendesk_sla_policy.standard.id,
+  ]
+}
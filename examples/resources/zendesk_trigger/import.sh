@@ -0,0 +1,2 @@
+# Trigger can be imported by specifying its numeric identifier.
+terraform import zendesk_trigger.example 123456
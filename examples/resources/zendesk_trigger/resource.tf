@@ -0,0 +1,40 @@
+# Notifies a webhook and escalates urgent tickets that are waiting in a custom status.
+# The conditions and actions are validated against the trigger definitions of the account while planning.
+resource "zendesk_trigger" "escalation" {
+  # Either title or raw_title with dynamic content placeholders
+  raw_title   = "{{dc.escalation_trigger}}"
+  description = "Escalates urgent tickets waiting for a partner"
+  category_id = zendesk_trigger_category.escalations.id
+
+  all {
+    field    = "update_type"
+    operator = "is"
+    value    = "Change"
+  }
+
+  all {
+    field    = "priority"
+    operator = "is"
+    value    = "urgent"
+  }
+
+  # Conditions and actions taking a list use values instead of value
+  all {
+    field    = "custom_status_id"
+    operator = "includes"
+    values   = [zendesk_custom_status.waiting_for_partner.custom_status_id]
+  }
+
+  action {
+    field = "group_id"
+    value = "360001234567"
+  }
+
+  action {
+    field = "notification_webhook"
+    values = [
+      zendesk_webhook.escalations.webhook_id,
+      jsonencode({ ticket_id = "{{ticket.id}}" }),
+    ]
+  }
+}
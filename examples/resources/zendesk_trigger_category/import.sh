@@ -0,0 +1,2 @@
+# Trigger category can be imported by specifying its identifier.
+terraform import zendesk_trigger_category.example 10605205526161
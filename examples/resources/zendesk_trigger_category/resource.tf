@@ -0,0 +1,9 @@
+# Triggers run category by category, a category groups the triggers of one concern.
+resource "zendesk_trigger_category" "routing" {
+  name = "Routing"
+}
+
+resource "zendesk_trigger_category" "notifications" {
+  name     = "Notifications"
+  position = 2
+}
@@ -0,0 +1,17 @@
+# Declares a whole routing ruleset: the categories run in the order of the blocks
+# and the listed triggers are moved into their category in the given order.
+# Everything is applied in one batch job of Zendesk.
+resource "zendesk_trigger_category_order" "ruleset" {
+  category {
+    id = zendesk_trigger_category.routing.id
+    trigger_ids = [
+      zendesk_trigger.route_vip.id,
+      zendesk_trigger.route_by_language.id,
+    ]
+  }
+
+  # The triggers of this category are left as they are
+  category {
+    id = zendesk_trigger_category.notifications.id
+  }
+}
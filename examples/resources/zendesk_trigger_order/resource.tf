@@ -0,0 +1,10 @@
+# The triggers of all modules fire in one declared order: the listed triggers first,
+# followed by the other triggers of the account.
+# Zendesk only allows this for accounts with one trigger category.
+resource "zendesk_trigger_order" "all" {
+  trigger_ids = [
+    zendesk_trigger.route_vip.id,
+    zendesk_trigger.escalation.id,
+    zendesk_trigger.notify_requester.id,
+  ]
+}
@@ -0,0 +1,2 @@
+# View can be imported by specifying its numeric identifier.
+terraform import zendesk_view.example 123456
@@ -0,0 +1,37 @@
+resource "zendesk_view" "vip_queue" {
+  title       = "VIP queue"
+  description = "Open tickets of VIP customers"
+
+  all {
+    field    = "status"
+    operator = "less_than"
+    value    = "solved"
+  }
+  all {
+    field    = "current_tags"
+    operator = "includes"
+    value    = "vip"
+  }
+  any {
+    field    = "priority"
+    operator = "is"
+    value    = "urgent"
+  }
+  any {
+    field    = "priority"
+    operator = "is"
+    value    = "high"
+  }
+
+  output = {
+    columns    = ["status", "description", "requester", "assignee", "updated", "360011872073"]
+    group_by   = "assignee"
+    sort_by    = "updated"
+    sort_order = "desc"
+  }
+
+  restriction = {
+    type = "Group"
+    ids  = [zendesk_group.vip_support.id]
+  }
+}
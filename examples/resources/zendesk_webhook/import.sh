@@ -1,4 +1,7 @@
 # Webhook can be imported by specifying its identifier.
 terraform import zendesk_webhook.example 1x2y3z
-# It can also be imported by specifying the webhook Name.
-terraform import zendesk_webhook.example my-webhook
\ No newline at end of file
+# It can also be imported by specifying the webhook Name, when no other webhook has the same name.
+terraform import zendesk_webhook.example my-webhook
+# The prefixes id: and name: select how the webhook is looked up, e.g. for a name that looks like an identifier.
+terraform import zendesk_webhook.example id:1x2y3z
+terraform import zendesk_webhook.example name:my-webhook
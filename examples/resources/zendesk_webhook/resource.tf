@@ -49,4 +49,27 @@ resource "zendesk_webhook" "my_webhook" {
     # Attributes external_source and signing_secret cannot be set,
     # but might be returned by the API after the webhook is created.
   }
-}
\ No newline at end of file
+
+  # Optional. Sends a test request with the planned endpoint and authentication before the webhook is saved
+  # and fails the plan or apply, when the endpoint does not answer with a 2xx status.
+  verify_on_apply {
+    payload = jsonencode({ message = "Test request of Terraform" })
+  }
+}
+# Clone of a webhook whose credentials are set by hand in Zendesk.
+# Without authentication, the clone inherits the authentication of the cloned webhook,
+# the credentials never get into the Terraform state.
+resource "zendesk_webhook" "cloned_webhook" {
+  clone_from_id = "01GOLDENWEBHOOK00000000000"
+
+  webhook = {
+    name           = "My Cloned Webhook"
+    endpoint       = "https://example.com/other-webhook"
+    http_method    = "POST"
+    request_format = "json"
+    status         = "active"
+    subscriptions = [
+      "conditional_ticket_events"
+    ]
+  }
+}
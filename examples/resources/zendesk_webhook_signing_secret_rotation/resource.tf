@@ -0,0 +1,17 @@
+# Rotates the signing secret of a webhook every 90 days.
+# The rotation happens in the first apply after the period has passed.
+resource "zendesk_webhook_signing_secret_rotation" "my_webhook" {
+  webhook_id   = zendesk_webhook.my_webhook.webhook_id
+  rotate_after = "90d"
+
+  # Optional, any change of the values rotates the secret as well
+  rotation_triggers = {
+    incident = "2024-06-01"
+  }
+}
+
+# Push the new secret into the secret store of the receiving service in the same apply
+output "webhook_signing_secret" {
+  value     = zendesk_webhook_signing_secret_rotation.my_webhook.secret
+  sensitive = true
+}
@@ -0,0 +1,175 @@
+package combined_provider
+
+import (
+	"context"
+	"net/http"
+	"terraform-provider-zendesk/internal/provider_config"
+	"terraform-provider-zendesk/zendesk_http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	client "github.com/nukosuke/go-zendesk/zendesk"
+	"github.com/nukosuke/terraform-provider-zendesk/zendesk"
+)
+
+// newNukosukeProvider returns the nukosuke SDKv2 provider with its provider schema aligned to
+// the plugin framework provider and its configuration replaced by the shared credential chain.
+func newNukosukeProvider() *schema.Provider {
+	nukosukeProvider := zendesk.Provider()
+	// the plugin framework provider implements these resources
+	delete(nukosukeProvider.ResourcesMap, "zendesk_trigger")
+
+	nukosukeProvider.Schema["account"].Description = provider_config.AccountDescription
+	nukosukeProvider.Schema["api_url"] = &schema.Schema{
+		Description: provider_config.ApiUrlDescription,
+		Type:        schema.TypeString,
+		Optional:    true,
+	}
+	nukosukeProvider.Schema["email"].Description = provider_config.EmailDescription
+	nukosukeProvider.Schema["token"].Description = provider_config.TokenDescription
+	nukosukeProvider.Schema["oauth_token"] = &schema.Schema{
+		Description: provider_config.OAuthTokenDescription,
+		Type:        schema.TypeString,
+		Optional:    true,
+		Sensitive:   true,
+	}
+	nukosukeProvider.Schema["oauth_client_id"] = &schema.Schema{
+		Description: provider_config.OAuthClientIdDescription,
+		Type:        schema.TypeString,
+		Optional:    true,
+	}
+	nukosukeProvider.Schema["oauth_client_secret"] = &schema.Schema{
+		Description: provider_config.OAuthSecretDescription,
+		Type:        schema.TypeString,
+		Optional:    true,
+		Sensitive:   true,
+	}
+	nukosukeProvider.Schema["oauth_scope"] = &schema.Schema{
+		Description: provider_config.OAuthScopeDescription,
+		Type:        schema.TypeString,
+		Optional:    true,
+	}
+	nukosukeProvider.Schema["max_retries"] = &schema.Schema{
+		Description:  provider_config.MaxRetriesDescription,
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntBetween(0, zendesk_http.MaxRetriesLimit),
+	}
+	nukosukeProvider.Schema["retry_max_wait"] = &schema.Schema{
+		Description: provider_config.RetryMaxWaitDescription,
+		Type:        schema.TypeInt,
+		Optional:    true,
+	}
+	nukosukeProvider.Schema["requests_per_minute"] = &schema.Schema{
+		Description: provider_config.RequestsPerMinuteDescription,
+		Type:        schema.TypeInt,
+		Optional:    true,
+	}
+	nukosukeProvider.Schema["profile"] = &schema.Schema{
+		Description: provider_config.ProfileDescription,
+		Type:        schema.TypeString,
+		Optional:    true,
+	}
+	nukosukeProvider.Schema["credentials_file"] = &schema.Schema{
+		Description: provider_config.CredentialsFileDescription,
+		Type:        schema.TypeString,
+		Optional:    true,
+	}
+	nukosukeProvider.Schema["validate_credentials"] = &schema.Schema{
+		Description: provider_config.ValidateCredentialsDescription,
+		Type:        schema.TypeBool,
+		Optional:    true,
+	}
+
+	nukosukeProvider.ConfigureContextFunc = configureNukosukeProvider
+	return nukosukeProvider
+}
+
+func configureNukosukeProvider(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	credentials, err := provider_config.ResolveCredentials(provider_config.ExplicitCredentials{
+		Account:         rawConfigString(d, "account"),
+		ApiUrl:          rawConfigString(d, "api_url"),
+		Email:           rawConfigString(d, "email"),
+		Token:           rawConfigString(d, "token"),
+		OAuthToken:      rawConfigString(d, "oauth_token"),
+		OAuthClientId:   rawConfigString(d, "oauth_client_id"),
+		OAuthSecret:     rawConfigString(d, "oauth_client_secret"),
+		OAuthScope:      rawConfigString(d, "oauth_scope"),
+		Profile:         rawConfigString(d, "profile"),
+		CredentialsFile: rawConfigString(d, "credentials_file"),
+	})
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	tflog.Info(ctx, "Resolved Zendesk credentials for the nukosuke provider", map[string]any{
+		"sources":     credentials.Sources(),
+		"auth_method": credentials.AuthMethod(),
+	})
+
+	serverUrl, err := credentials.ServerUrl()
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	if serverUrl == "" {
+		return nil, diag.Errorf("missing Zendesk API account: set account or api_url in the configuration, the environment or the credentials file")
+	}
+
+	httpSettings := provider_config.HttpSettings{
+		MaxRetries:        rawConfigInt64(d, "max_retries"),
+		RetryMaxWait:      rawConfigInt64(d, "retry_max_wait"),
+		RequestsPerMinute: rawConfigInt64(d, "requests_per_minute"),
+	}
+	if err := httpSettings.Validate(); err != nil {
+		return nil, diag.FromErr(err)
+	}
+	httpClient := httpSettings.NewHttpClient(httpSettings.SharedRateLimiter(serverUrl))
+
+	authenticator, err := credentials.NewAuthenticator(ctx, httpClient, serverUrl)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	// go-zendesk only knows Basic authentication, so no credential is set on the client
+	// and the shared authenticator adds the Authorization header instead
+	zd, err := client.NewClient(&http.Client{
+		Transport: &zendesk_http.AuthenticatingTransport{Authenticator: authenticator, Base: httpClient.Transport},
+	})
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	if err = zd.SetEndpointURL(serverUrl + "/api/v2"); err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	return zd, nil
+}
+
+// rawConfigString reads an attribute as written in the provider block, without the SDKv2
+// environment variable defaults, so the credential chain can tell the sources apart.
+func rawConfigString(d *schema.ResourceData, name string) string {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() || !config.Type().HasAttribute(name) {
+		return ""
+	}
+	value := config.GetAttr(name)
+	if value.IsNull() || !value.IsKnown() {
+		return ""
+	}
+	return value.AsString()
+}
+
+func rawConfigInt64(d *schema.ResourceData, name string) *int64 {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() || !config.Type().HasAttribute(name) {
+		return nil
+	}
+	value := config.GetAttr(name)
+	if value.IsNull() || !value.IsKnown() {
+		return nil
+	}
+	intValue, _ := value.AsBigFloat().Int64()
+	return &intValue
+}
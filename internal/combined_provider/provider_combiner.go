@@ -2,27 +2,24 @@ package combined_provider
 
 import (
 	"context"
+	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
 	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
-	"github.com/nukosuke/terraform-provider-zendesk/zendesk"
-	"log"
 )
 
 func BuildMuxProviderServer(pluginFrameworkProvider provider.Provider) (*tfprotov6.ProviderServer, error) {
 	ctx := context.Background()
 
 	// upgrade the zendesk provider to the Terraform Plugin Framework (version 6.0)
-	upgradedNukosukeZendeskProvider, err2 := tf5to6server.UpgradeServer(
+	upgradedNukosukeZendeskProvider, err := tf5to6server.UpgradeServer(
 		ctx,
-		zendesk.Provider().GRPCProvider,
+		newNukosukeProvider().GRPCProvider,
 	)
-
-	if err2 != nil {
-		log.Fatal(err2)
-
+	if err != nil {
+		return nil, fmt.Errorf("upgrading the nukosuke Zendesk provider to protocol version 6: %w", err)
 	}
 
 	providers := []func() tfprotov6.ProviderServer{
@@ -40,5 +37,5 @@ func BuildMuxProviderServer(pluginFrameworkProvider provider.Provider) (*tfproto
 		return nil, err
 	}
 	server := muxServer.ProviderServer()
-	return &server, err
+	return &server, nil
 }
@@ -0,0 +1,24 @@
+package combined_provider
+
+import (
+	"context"
+	"terraform-provider-zendesk/internal/provider"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"gotest.tools/v3/assert"
+)
+
+// The mux server rejects provider schemas which differ between the combined providers,
+// so every provider attribute has to be declared identically on both sides.
+func TestBuildMuxProviderServer_ProviderSchemasAreIdentical(t *testing.T) {
+	server, err := BuildMuxProviderServer(provider.New("test")())
+	assert.NilError(t, err)
+
+	schemaResponse, err := (*server).GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	assert.NilError(t, err)
+	for _, diagnostic := range schemaResponse.Diagnostics {
+		assert.Assert(t, diagnostic.Severity != tfprotov6.DiagnosticSeverityError, "%s: %s", diagnostic.Summary, diagnostic.Detail)
+	}
+	assert.Assert(t, schemaResponse.Provider != nil)
+}
@@ -0,0 +1,105 @@
+package datasource_webhook
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"terraform-provider-zendesk/internal/resource_webhook"
+	"terraform-provider-zendesk/zendesk_webhook_api"
+)
+
+// PutWebhookShowResponseToDataSourceModel maps the webhook with the resource_webhook.WebhookMapper and
+// converts the result to the data source model. The data source schema equals the resource schema,
+// except that the authentication data holds the username only.
+func PutWebhookShowResponseToDataSourceModel(ctx context.Context, showWebhookResponse *zendesk_webhook_api.ShowWebhookWrap, webhookId *types.String, webhook *WebhookValue) diag.Diagnostics {
+	// the mapper fills a known webhook and merges the authentication into a known prior value
+	resourceWebhookValue, diags := resource_webhook.NewWebhookValue(resource_webhook.WebhookValue{}.AttributeTypes(ctx),
+		nullAttributes(ctx, resource_webhook.WebhookValue{}.AttributeTypes(ctx)))
+	if diags.HasError() {
+		return diags
+	}
+	authentication, diags := resource_webhook.NewAuthenticationValue(resource_webhook.AuthenticationValue{}.AttributeTypes(ctx),
+		nullAttributes(ctx, resource_webhook.AuthenticationValue{}.AttributeTypes(ctx)))
+	if diags.HasError() {
+		return diags
+	}
+	resourceWebhookValue.Authentication, diags = authentication.ToObjectValue(ctx)
+	if diags.HasError() {
+		return diags
+	}
+	resourceModel := resource_webhook.WebhookModel{Webhook: resourceWebhookValue}
+
+	diags = resource_webhook.NewWebhookMapper().PutWebhookShowResponseToStateModel(ctx, showWebhookResponse, &resourceModel)
+	if diags.HasError() {
+		return diags
+	}
+
+	resourceWebhook, diags := resourceModel.Webhook.ToObjectValue(ctx)
+	if diags.HasError() {
+		tflog.Error(ctx, "Error reading webhook data from the API: ", map[string]interface{}{"error": diags})
+		return diags
+	}
+
+	attributes := resourceWebhook.Attributes()
+	attributes["authentication"], diags = withoutSensitiveAuthenticationData(ctx, resourceModel.Webhook.Authentication)
+	if diags.HasError() {
+		tflog.Error(ctx, "Error reading webhook authentication from the API: ", map[string]interface{}{"error": diags})
+		return diags
+	}
+
+	*webhook, diags = NewWebhookValue(WebhookValue{}.AttributeTypes(ctx), attributes)
+	if diags.HasError() {
+		return diags
+	}
+	*webhookId = resourceModel.WebhookId
+	return nil
+}
+
+// withoutSensitiveAuthenticationData drops the password and token, which the API never returns. A webhook
+// without authentication is mapped by the resource mapper to an authentication without type, it is null here.
+func withoutSensitiveAuthenticationData(ctx context.Context, authentication basetypes.ObjectValue) (basetypes.ObjectValue, diag.Diagnostics) {
+	attributes := authentication.Attributes()
+	if authentication.IsNull() || attributes["type"] == nil || attributes["type"].IsNull() {
+		return types.ObjectNull(AuthenticationValue{}.AttributeTypes(ctx)), nil
+	}
+
+	data, ok := attributes["data"].(basetypes.ObjectValue)
+	if !ok || data.IsNull() {
+		attributes["data"] = types.ObjectNull(DataValue{}.AttributeTypes(ctx))
+	} else {
+		dataValue, diags := NewDataValue(DataValue{}.AttributeTypes(ctx), map[string]attr.Value{
+			"username": data.Attributes()["username"],
+		})
+		if diags.HasError() {
+			return basetypes.ObjectValue{}, diags
+		}
+		attributes["data"], diags = dataValue.ToObjectValue(ctx)
+		if diags.HasError() {
+			return basetypes.ObjectValue{}, diags
+		}
+	}
+
+	authenticationValue, diags := NewAuthenticationValue(AuthenticationValue{}.AttributeTypes(ctx), attributes)
+	if diags.HasError() {
+		return basetypes.ObjectValue{}, diags
+	}
+	return authenticationValue.ToObjectValue(ctx)
+}
+
+// nullAttributes returns a null value for every attribute type, to create a known object with unset attributes.
+func nullAttributes(ctx context.Context, attributeTypes map[string]attr.Type) map[string]attr.Value {
+	attributes := make(map[string]attr.Value, len(attributeTypes))
+	for name, attributeType := range attributeTypes {
+		value, err := attributeType.ValueFromTerraform(ctx, tftypes.NewValue(attributeType.TerraformType(ctx), nil))
+		if err != nil {
+			panic(fmt.Sprintf("null value of attribute %s: %v", name, err))
+		}
+		attributes[name] = value
+	}
+	return attributes
+}
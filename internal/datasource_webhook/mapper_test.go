@@ -0,0 +1,63 @@
+package datasource_webhook
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gotest.tools/v3/assert"
+	"terraform-provider-zendesk/zendesk_webhook_api"
+	"testing"
+)
+
+func TestPutWebhookShowResponseToDataSourceModel(t *testing.T) {
+	tests := []struct {
+		name               string
+		response           string
+		wantUsername       types.String
+		wantAuthentication bool
+	}{
+		{name: "basic auth",
+			response: `{"JSON200": {"webhook": {"name": "test-webhook", "id": "123456", "endpoint": "https://example.com",
+				"authentication": {"type": "basic_auth", "data": {"username": "test-user"}, "add_position": "header"},
+				"request_format": "json", "http_method": "POST", "status": "active",
+				"custom_headers": {"header1": "value1"},
+				"subscriptions": ["conditional_ticket_events"],
+				"signing_secret": {"secret": "secret-value", "algorithm": "SHA256"}}}}`,
+			wantUsername:       types.StringValue("test-user"),
+			wantAuthentication: true},
+		{name: "no authentication",
+			response: `{"JSON200": {"webhook": {"name": "test-webhook", "id": "123456", "endpoint": "https://example.com",
+				"request_format": "json", "http_method": "POST", "status": "active"}}}`,
+			wantAuthentication: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			var response zendesk_webhook_api.ShowWebhookWrap
+			assert.NilError(t, json.Unmarshal([]byte(tt.response), &response))
+
+			var webhookId types.String
+			var webhook WebhookValue
+			diags := PutWebhookShowResponseToDataSourceModel(ctx, &response, &webhookId, &webhook)
+			assert.Assert(t, !diags.HasError(), "unexpected diagnostics %v", diags)
+
+			assert.Equal(t, webhookId, types.StringValue("123456"))
+			assert.Equal(t, webhook.Id, types.StringValue("123456"))
+			assert.Equal(t, webhook.Name, types.StringValue("test-webhook"))
+			assert.Equal(t, webhook.Endpoint, types.StringValue("https://example.com"))
+			assert.Equal(t, webhook.Authentication.IsNull(), !tt.wantAuthentication)
+			if tt.wantAuthentication {
+				authentication := webhook.Authentication.Attributes()
+				assert.Equal(t, authentication["type"], types.StringValue("basic_auth"))
+				data, ok := authentication["data"].(types.Object)
+				assert.Assert(t, ok)
+				assert.Equal(t, len(data.Attributes()), 1, "the data source exposes the username only")
+				assert.Equal(t, data.Attributes()["username"], tt.wantUsername)
+			}
+
+			// the model must be accepted by the data source schema
+			_, err := webhook.ToTerraformValue(ctx)
+			assert.NilError(t, err)
+		})
+	}
+}
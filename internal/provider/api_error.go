@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"terraform-provider-zendesk/zendesk_http"
+)
+
+// attributePathFunc returns the Terraform path of a field Zendesk reported a validation error for.
+type attributePathFunc func(field string) (path.Path, bool)
+
+// nestedAttributePaths maps the fields of the object in the root attribute to their Terraform paths. The fields
+// may be prefixed with the name of the object, like webhook.endpoint in the Webhook API. Numeric segments index
+// lists, and a field below the attributes of the schema is reported on the deepest attribute that exists.
+func nestedAttributePaths(root string, attributeTypes map[string]attr.Type) attributePathFunc {
+	return func(field string) (path.Path, bool) {
+		segments := strings.Split(strings.TrimPrefix(field, root+"."), ".")
+		attributeType, exists := attributeTypes[segments[0]]
+		if !exists {
+			return path.Empty(), false
+		}
+		attributePath := path.Root(root).AtName(segments[0])
+		for _, segment := range segments[1:] {
+			var ok bool
+			attributePath, attributeType, ok = attributePathStep(attributePath, attributeType, segment)
+			if !ok {
+				break
+			}
+		}
+		return attributePath, true
+	}
+}
+
+// attributePathStep descends from the attribute at attributePath into the segment of a field. It returns false and
+// the unchanged path when the type of the attribute has no such segment.
+func attributePathStep(attributePath path.Path, attributeType attr.Type, segment string) (path.Path, attr.Type, bool) {
+	switch typed := attributeType.(type) {
+	case attr.TypeWithAttributeTypes:
+		if nestedType, exists := typed.AttributeTypes()[segment]; exists {
+			return attributePath.AtName(segment), nestedType, true
+		}
+	case basetypes.ListTypable:
+		index, err := strconv.Atoi(segment)
+		if elementType, ok := attributeType.(attr.TypeWithElementType); ok && err == nil && index >= 0 {
+			return attributePath.AtListIndex(index), elementType.ElementType(), true
+		}
+	case basetypes.MapTypable:
+		if elementType, ok := attributeType.(attr.TypeWithElementType); ok {
+			return attributePath.AtMapKey(segment), elementType.ElementType(), true
+		}
+	}
+	return attributePath, attributeType, false
+}
+
+// addAPIError adds the error answer of the Zendesk API to the diagnostics. Validation errors of fields known to
+// attributePaths are added to the Terraform attributes, everything else to an error with the summary.
+func addAPIError(diags *diag.Diagnostics, summary string, response *http.Response, body []byte, attributePaths attributePathFunc) {
+	apiError := zendesk_http.NewAPIError(response, body)
+
+	requestId := ""
+	if apiError.RequestId != "" {
+		requestId = "\nZendesk request id: " + apiError.RequestId
+	}
+
+	unmappedErrors := make([]zendesk_http.FieldError, 0, len(apiError.FieldErrors))
+	for _, fieldError := range apiError.FieldErrors {
+		if attributePaths != nil {
+			if attributePath, ok := attributePaths(fieldError.Field); ok {
+				diags.AddAttributeError(attributePath, summary, fieldError.Description+requestId)
+				continue
+			}
+		}
+		unmappedErrors = append(unmappedErrors, fieldError)
+	}
+
+	if len(apiError.FieldErrors) == 0 || len(unmappedErrors) > 0 {
+		apiError.FieldErrors = unmappedErrors
+		diags.AddError(summary, apiError.Error())
+	}
+}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"gotest.tools/v3/assert"
+	"terraform-provider-zendesk/internal/resource_webhook"
+)
+
+func TestAddAPIError(t *testing.T) {
+	attributePaths := nestedAttributePaths("webhook", resource_webhook.WebhookValue{}.AttributeTypes(context.Background()))
+	tests := []struct {
+		name      string
+		body      string
+		wantDiags diag.Diagnostics
+	}{
+		{name: "field errors on known attributes",
+			body: `{"errors":[{"code":"InvalidValue","detail":"Endpoint must be https","source":{"pointer":"/webhook/endpoint"}},
+				{"code":"InvalidValue","detail":"Password is too short","source":{"pointer":"/webhook/authentication/data/password"}}]}`,
+			wantDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("webhook").AtName("endpoint"), "Error saving webhook",
+					"Endpoint must be https\nZendesk request id: req-1"),
+				diag.NewAttributeErrorDiagnostic(path.Root("webhook").AtName("authentication").AtName("data").AtName("password"), "Error saving webhook",
+					"Password is too short\nZendesk request id: req-1"),
+			}},
+		{name: "field errors below the attributes",
+			body: `{"errors":[{"code":"InvalidValue","detail":"Value is invalid","source":{"pointer":"/webhook/authentication/data/0/value"}},
+				{"code":"InvalidValue","detail":"Unknown event","source":{"pointer":"/webhook/subscriptions/1"}},
+				{"code":"InvalidValue","detail":"Header is reserved","source":{"pointer":"/webhook/custom_headers/Host"}}]}`,
+			wantDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("webhook").AtName("authentication").AtName("data"), "Error saving webhook",
+					"Value is invalid\nZendesk request id: req-1"),
+				diag.NewAttributeErrorDiagnostic(path.Root("webhook").AtName("subscriptions").AtListIndex(1), "Error saving webhook",
+					"Unknown event\nZendesk request id: req-1"),
+				diag.NewAttributeErrorDiagnostic(path.Root("webhook").AtName("custom_headers").AtMapKey("Host"), "Error saving webhook",
+					"Header is reserved\nZendesk request id: req-1"),
+			}},
+		{name: "field error on an unknown attribute",
+			body: `{"error":"RecordInvalid","description":"Record validation errors","details":{"base":[{"description":"Too many webhooks","error":"Limit"}]}}`,
+			wantDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Error saving webhook",
+					"Zendesk answered with the status 422 RecordInvalid: Record validation errors\nbase: Too many webhooks\nZendesk request id: req-1"),
+			}},
+		{name: "no field errors",
+			body: `{"error":"RecordNotFound","description":"Not found"}`,
+			wantDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Error saving webhook",
+					"Zendesk answered with the status 422 RecordNotFound: Not found\nZendesk request id: req-1"),
+			}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response := &http.Response{StatusCode: http.StatusUnprocessableEntity, Header: http.Header{}}
+			response.Header.Set("X-Zendesk-Request-Id", "req-1")
+
+			var diags diag.Diagnostics
+			addAPIError(&diags, "Error saving webhook", response, []byte(tt.body), attributePaths)
+
+			assert.DeepEqual(t, diags, tt.wantDiags)
+		})
+	}
+}
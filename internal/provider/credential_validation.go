@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"terraform-provider-zendesk/internal/provider_config"
+	"terraform-provider-zendesk/zendesk_api"
+)
+
+// zendeskUser is the user the provider is authenticated as, read from /api/v2/users/me.
+type zendeskUser struct {
+	Id    *int64 `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+	Role  string `json:"role"`
+}
+
+// validateCredentials calls /api/v2/users/me to check the account, the credentials and the role of
+// the authenticated user before any resource is touched. Problems are reported as attribute errors
+// on the configuration value most likely to be wrong.
+func validateCredentials(ctx context.Context, supportApi *zendesk_api.SupportApi, credentials *provider_config.Credentials, diagnostics *diag.Diagnostics) *zendeskUser {
+	endpointPath := path.Root("account")
+	if credentials.ApiUrl.Value != "" {
+		endpointPath = path.Root("api_url")
+	}
+	credentialsPath := credentialsAttribute(credentials)
+
+	tflog.Debug(ctx, "Validating Zendesk credentials")
+	response, err := supportApi.GetClient().ShowCurrentUserWithResponse(ctx)
+	if err != nil {
+		tflog.Error(ctx, "Error reading the current Zendesk user", map[string]any{"error": err.Error()})
+		diagnostics.AddAttributeError(
+			endpointPath,
+			"Zendesk Account Not Reachable",
+			"The provider cannot reach the Zendesk API to validate the credentials: "+err.Error(),
+		)
+		return nil
+	}
+
+	switch response.StatusCode() {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		diagnostics.AddAttributeError(
+			endpointPath,
+			"Unknown Zendesk Account",
+			fmt.Sprintf("The Zendesk API returned %s for the current user, check that the account exists. "+
+				"Set validate_credentials to false to skip this check.", response.Status()),
+		)
+		return nil
+	case http.StatusUnauthorized:
+		diagnostics.AddAttributeError(
+			credentialsPath,
+			"Invalid Zendesk Credentials",
+			fmt.Sprintf("The Zendesk API rejected the %s credentials with %s. Check that the credentials belong to the configured account. "+
+				"Set validate_credentials to false to skip this check.", credentials.AuthMethod(), response.Status()),
+		)
+		return nil
+	default:
+		diagnostics.AddError(
+			"Unable to Validate Zendesk Credentials",
+			fmt.Sprintf("The Zendesk API returned %s for the current user. Set validate_credentials to false to skip this check.", response.Status()),
+		)
+		return nil
+	}
+
+	var body struct {
+		User zendeskUser `json:"user"`
+	}
+	if err := json.Unmarshal(response.Body, &body); err != nil {
+		diagnostics.AddError(
+			"Unable to Validate Zendesk Credentials",
+			"The current user returned by the Zendesk API cannot be read: "+err.Error(),
+		)
+		return nil
+	}
+	user := body.User
+
+	// Zendesk answers requests it cannot authenticate with the anonymous user instead of an error
+	if user.Id == nil {
+		diagnostics.AddAttributeError(
+			credentialsPath,
+			"Invalid Zendesk Credentials",
+			fmt.Sprintf("The Zendesk API did not authenticate the %s credentials, the request was handled as an anonymous user. "+
+				"Check that the credentials belong to the configured account.", credentials.AuthMethod()),
+		)
+		return nil
+	}
+
+	if credentials.AuthMethod() == provider_config.AuthMethodAPIToken && !strings.EqualFold(user.Email, credentials.Email.Value) {
+		diagnostics.AddAttributeError(
+			path.Root("email"),
+			"Zendesk Email Does Not Match",
+			fmt.Sprintf("The API token authenticated the user %q, but the configured email is %q.", user.Email, credentials.Email.Value),
+		)
+		return nil
+	}
+
+	if user.Role != "admin" {
+		identityPath := credentialsPath
+		if credentials.AuthMethod() == provider_config.AuthMethodAPIToken {
+			identityPath = path.Root("email")
+		}
+		diagnostics.AddAttributeError(
+			identityPath,
+			"Insufficient Zendesk Role",
+			fmt.Sprintf("The provider is authenticated as %q with the role %q. Managing the Zendesk configuration requires an admin.", user.Email, user.Role),
+		)
+		return nil
+	}
+
+	tflog.Info(ctx, "Validated Zendesk credentials", map[string]any{"user_id": *user.Id, "role": user.Role})
+	return &user
+}
+
+// readAccountFeatures reads the features of the account plan. Zendesk does not expose the plan
+// itself, the active features are the closest indicator of what the plan allows. Failures are only
+// logged, the features are informational.
+func readAccountFeatures(ctx context.Context, supportApi *zendesk_api.SupportApi) *zendesk_api.AccountSettingsActiveFeaturesObject {
+	response, err := supportApi.GetClient().ShowAccountSettingsWithResponse(ctx)
+	if err != nil {
+		tflog.Warn(ctx, "Error reading the Zendesk account settings", map[string]any{"error": err.Error()})
+		return nil
+	}
+	if response.JSON200 == nil || response.JSON200.Settings == nil {
+		tflog.Warn(ctx, "Unable to read the Zendesk account settings", map[string]any{"status": response.Status()})
+		return nil
+	}
+	return response.JSON200.Settings.ActiveFeatures
+}
+
+// credentialsAttribute is the attribute holding the secret of the auth method in use.
+func credentialsAttribute(credentials *provider_config.Credentials) path.Path {
+	switch credentials.AuthMethod() {
+	case provider_config.AuthMethodOAuthToken:
+		return path.Root("oauth_token")
+	case provider_config.AuthMethodClientCredentials:
+		return path.Root("oauth_client_id")
+	default:
+		return path.Root("token")
+	}
+}
@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"gotest.tools/v3/assert"
+	"terraform-provider-zendesk/internal/provider_config"
+	"terraform-provider-zendesk/zendesk_api"
+	"terraform-provider-zendesk/zendesk_http"
+)
+
+func TestValidateCredentials(t *testing.T) {
+	tests := []struct {
+		name          string
+		status        int
+		body          string
+		wantAttribute path.Path
+		wantSummary   string
+	}{
+		{name: "admin",
+			status: http.StatusOK,
+			body:   `{"user":{"id":1,"name":"Admin","email":"Admin@example.com","role":"admin"}}`},
+		{name: "unknown account",
+			status:        http.StatusNotFound,
+			body:          `{"error":"InvalidEndpoint"}`,
+			wantAttribute: path.Root("api_url"),
+			wantSummary:   "Unknown Zendesk Account"},
+		{name: "wrong token",
+			status:        http.StatusUnauthorized,
+			body:          `{"error":"Couldn't authenticate you"}`,
+			wantAttribute: path.Root("token"),
+			wantSummary:   "Invalid Zendesk Credentials"},
+		{name: "anonymous user",
+			status:        http.StatusOK,
+			body:          `{"user":{"id":null,"name":"Anonymous user","email":"invalid@example.com","role":"end-user"}}`,
+			wantAttribute: path.Root("token"),
+			wantSummary:   "Invalid Zendesk Credentials"},
+		{name: "other email",
+			status:        http.StatusOK,
+			body:          `{"user":{"id":2,"name":"Other","email":"other@example.com","role":"admin"}}`,
+			wantAttribute: path.Root("email"),
+			wantSummary:   "Zendesk Email Does Not Match"},
+		{name: "agent",
+			status:        http.StatusOK,
+			body:          `{"user":{"id":3,"name":"Agent","email":"admin@example.com","role":"agent"}}`,
+			wantAttribute: path.Root("email"),
+			wantSummary:   "Insufficient Zendesk Role"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, r.URL.Path, "/api/v2/users/me")
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			supportApi, err := zendesk_api.NewSupportApi(server.URL, zendesk_http.NewAPITokenAuthenticator("admin@example.com", "t"), nil)
+			assert.NilError(t, err)
+			credentials := &provider_config.Credentials{
+				ApiUrl: provider_config.ResolvedValue{Value: server.URL},
+				Email:  provider_config.ResolvedValue{Value: "admin@example.com"},
+				Token:  provider_config.ResolvedValue{Value: "t"},
+			}
+
+			var diagnostics diag.Diagnostics
+			user := validateCredentials(context.Background(), supportApi, credentials, &diagnostics)
+
+			if tt.wantSummary == "" {
+				assert.Assert(t, !diagnostics.HasError(), "unexpected diagnostics %v", diagnostics)
+				assert.Equal(t, *user.Id, int64(1))
+				return
+			}
+			assert.Assert(t, user == nil)
+			assert.Equal(t, diagnostics.ErrorsCount(), 1)
+			withPath, ok := diagnostics.Errors()[0].(diag.DiagnosticWithPath)
+			assert.Assert(t, ok, "expected an attribute error")
+			assert.Equal(t, withPath.Path().String(), tt.wantAttribute.String())
+			assert.Equal(t, withPath.Summary(), tt.wantSummary)
+		})
+	}
+}
@@ -44,26 +44,17 @@ func (r *customStatusResource) ImportState(ctx context.Context, request resource
 	if intParsingError != nil {
 		tflog.Debug(ctx, "custom status id could not be parsed as a number: "+intParsingError.Error())
 		tflog.Info(ctx, "Will try to find a custom status by the label: "+id)
-		customStatusesResponse, errors := r.client.GetClient().ListCustomStatusesWithResponse(ctx, nil, jsonContenttypeHeaderEditor)
-		if errors != nil {
-			tflog.Error(ctx, "Error listing custom statuses: ", map[string]any{"error": errors.Error()})
-			response.Diagnostics.AddError("Error listing custom statuses", errors.Error())
-			return
-		}
-		if customStatusesResponse.HTTPResponse.StatusCode != 200 {
-			msg := "API error listing custom statuses: " + customStatusesResponse.HTTPResponse.Status
-			tflog.Error(ctx, msg)
-			response.Diagnostics.AddError(msg, "List custom statuses failed with status code: "+customStatusesResponse.HTTPResponse.Status)
-			return
-		}
-		customStatuses := customStatusesResponse.JSON200.CustomStatuses
-		tflog.Debug(ctx, "Found custom statuses: "+fmt.Sprintf("%v", customStatuses))
-		for _, customStatus := range *customStatuses {
+		err := r.client.ForEachCustomStatus(ctx, nil, func(customStatus zendesk_api.CustomStatusObject) bool {
 			if customStatus.AgentLabel == id {
 				idInt = int64(*customStatus.Id)
-				break
+				return false
 			}
-
+			return true
+		})
+		if err != nil {
+			tflog.Error(ctx, "Error listing custom statuses: ", map[string]any{"error": err.Error()})
+			response.Diagnostics.AddError("Error listing custom statuses", err.Error())
+			return
 		}
 	}
 	if idInt == 0 {
@@ -87,6 +78,9 @@ func (r *customStatusResource) ImportState(ctx context.Context, request resource
 	tflog.Info(ctx, "ImportState custom status completed successfully")
 }
 
+// customStatusAttributePaths maps the fields of validation errors of the custom status API to the Terraform paths.
+var customStatusAttributePaths = nestedAttributePaths("custom_status", resource_custom_status.CustomStatusValue{}.AttributeTypes(context.Background()))
+
 // Configure adds the provider configured client to the resource.
 func (r *customStatusResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
 	// Add a nil check when handling ProviderData because Terraform
@@ -162,9 +156,8 @@ func (r *customStatusResource) Create(ctx context.Context, req resource.CreateRe
 		"API call to create custom status ended with status: "+statusCode)
 
 	if customStatusCreateResponse.HTTPResponse.StatusCode != 201 {
-		msg := "API error creating custom status: " + statusCode
-		tflog.Error(ctx, msg)
-		resp.Diagnostics.AddError(msg, "Create custom status failed with status code: "+statusCode+" and body: "+string(customStatusCreateResponse.Body))
+		tflog.Error(ctx, "API error creating custom status: "+statusCode, map[string]any{"error": string(customStatusCreateResponse.Body)})
+		addAPIError(&resp.Diagnostics, "Error creating custom status", customStatusCreateResponse.HTTPResponse, customStatusCreateResponse.Body, customStatusAttributePaths)
 		return
 	}
 
@@ -292,14 +285,15 @@ func (r *customStatusResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
+	if removeResourceWhenNotFound(ctx, customStatusReadResponse.StatusCode(), "zendesk_custom_status", state.CustomStatusId.String(), resp) {
+		return
+	}
+
 	if customStatusReadResponse.HTTPResponse.StatusCode != 200 {
 		msg := "Error Reading Zendesk CustomStatus with id= " + state.CustomStatusId.String() + " and status: " + customStatusReadResponse.HTTPResponse.Status + " and body: <" + string(customStatusReadResponse.Body) + ">"
 		tflog.Error(ctx, msg)
 
-		resp.Diagnostics.AddError(
-			"Failure Reading Zendesk CustomStatus",
-			msg,
-		)
+		addAPIError(&resp.Diagnostics, "Failure Reading Zendesk CustomStatus", customStatusReadResponse.HTTPResponse, customStatusReadResponse.Body, nil)
 		return
 
 	}
@@ -401,9 +395,8 @@ func (r *customStatusResource) Update(ctx context.Context, req resource.UpdateRe
 	tflog.Debug(ctx, "API call to update custom status ended with status: "+statusCode)
 
 	if customStatusUpdateResponse.HTTPResponse.StatusCode != 200 {
-		msg := "API error updating custom status: " + statusCode
-		tflog.Error(ctx, msg)
-		resp.Diagnostics.AddError(msg, "Update custom status failed with status code: "+statusCode+" and body: "+string(customStatusUpdateResponse.Body))
+		tflog.Error(ctx, "API error updating custom status: "+statusCode, map[string]any{"error": string(customStatusUpdateResponse.Body)})
+		addAPIError(&resp.Diagnostics, "Error updating custom status", customStatusUpdateResponse.HTTPResponse, customStatusUpdateResponse.Body, customStatusAttributePaths)
 		return
 
 	}
@@ -482,10 +475,7 @@ func (r *customStatusResource) Delete(ctx context.Context, req resource.DeleteRe
 		msg := "Error Reading Zendesk CustomStatus with id= " + state.CustomStatusId.String() + " and status: " + customStatusReadResponse.HTTPResponse.Status + " and body: <" + string(customStatusReadResponse.Body) + ">"
 		tflog.Error(ctx, msg)
 
-		resp.Diagnostics.AddError(
-			"Failure Reading Zendesk CustomStatus",
-			msg,
-		)
+		addAPIError(&resp.Diagnostics, "Failure Reading Zendesk CustomStatus", customStatusReadResponse.HTTPResponse, customStatusReadResponse.Body, nil)
 		return
 
 	}
@@ -512,7 +502,7 @@ func (r *customStatusResource) Delete(ctx context.Context, req resource.DeleteRe
 
 		tflog.Debug(ctx, "Deactivated custom status with status: "+structToString(status))
 		if status.HTTPResponse.StatusCode != 200 {
-			resp.Diagnostics.AddError("Error deactivating custom status", "Deactivation failed with status code: "+status.HTTPResponse.Status)
+			addAPIError(&resp.Diagnostics, "Error deactivating custom status", status.HTTPResponse, status.Body, customStatusAttributePaths)
 			tflog.Error(ctx, "Error deactivating custom status: ", map[string]any{"status": status.HTTPResponse.Status})
 			return
 		}
@@ -0,0 +1,350 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"terraform-provider-zendesk/zendesk_api"
+)
+
+var (
+	_ resource.Resource                   = (*orderResource)(nil)
+	_ resource.ResourceWithConfigure      = (*orderResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*orderResource)(nil)
+)
+
+// positionedRule is a trigger or SLA policy with its position in the order Zendesk applies them in.
+type positionedRule struct {
+	id       int
+	position *int
+}
+
+// orderKind describes the rules an order resource orders: how to list them and how to push their full order.
+type orderKind struct {
+	typeName    string
+	attribute   string
+	noun        string
+	description string
+	list        func(ctx context.Context, client *zendesk_api.SupportApi) ([]positionedRule, error)
+	reorder     func(ctx context.Context, client *zendesk_api.SupportApi, ids []int, diags *diag.Diagnostics)
+}
+
+func NewTriggerOrderResource() resource.Resource {
+	return &orderResource{kind: orderKind{
+		typeName:  "_trigger_order",
+		attribute: "trigger_ids",
+		noun:      "trigger",
+		description: "Sets the order the triggers fire in. The listed triggers fire first, in the order of the list, " +
+			"followed by the other triggers of the account in their current order. The full order is pushed in one call. " +
+			"Zendesk only allows this for accounts with one trigger category, use `zendesk_trigger_category_order` otherwise. " +
+			"Do not also place the triggers with `zendesk_trigger_category_order`, both write the trigger positions and undo each other on every apply. " +
+			"Destroying the resource leaves the order as it is.",
+		list:    listTriggerPositions,
+		reorder: reorderTriggers,
+	}}
+}
+
+func NewSLAPolicyOrderResource() resource.Resource {
+	return &orderResource{kind: orderKind{
+		typeName:  "_sla_policy_order",
+		attribute: "sla_policy_ids",
+		noun:      "SLA policy",
+		description: "Sets the order SLA policies are matched in. The listed policies are matched first, in the order of the list, " +
+			"followed by the other policies of the account in their current order. The full order is pushed in one call. " +
+			"Destroying the resource leaves the order as it is.",
+		list:    listSLAPolicyPositions,
+		reorder: reorderSLAPolicies,
+	}}
+}
+
+type orderResource struct {
+	client *zendesk_api.SupportApi
+	kind   orderKind
+}
+
+func (r *orderResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.kind.typeName
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *orderResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := request.ProviderData.(zendeskProviderData)
+
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *zendeskProviderData, got: %T. Please report this issue to the provider developers.",
+				request.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.supportApi
+}
+
+func (r *orderResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: r.kind.description,
+		Attributes: map[string]schema.Attribute{
+			r.kind.attribute: schema.ListAttribute{
+				Description: fmt.Sprintf("Ids of the %s, in order.", pluralNoun(r.kind.noun)),
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+		},
+	}
+}
+
+func (r *orderResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var ids types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(r.kind.attribute), &ids)...)
+	if resp.Diagnostics.HasError() || ids.IsNull() || ids.IsUnknown() {
+		return
+	}
+
+	for i, element := range ids.Elements() {
+		id, ok := element.(types.String)
+		if !ok || id.IsNull() || id.IsUnknown() {
+			continue
+		}
+		if _, err := strconv.Atoi(id.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(r.kind.attribute).AtListIndex(i), "Invalid Id",
+				fmt.Sprintf("The id of a %s is a number, got %q", r.kind.noun, id.ValueString()))
+		}
+	}
+}
+
+func (r *orderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var ids types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(r.kind.attribute), &ids)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.applyOrder(ctx, ids, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(r.kind.attribute), ids)...)
+}
+
+func (r *orderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var ids types.List
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(r.kind.attribute), &ids)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listed, diags := orderIds(ctx, ids)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, err := r.kind.list(ctx, r.client)
+	if err != nil {
+		tflog.Error(ctx, "Error reading the "+r.kind.noun+" order: ", map[string]interface{}{"error": err})
+		resp.Diagnostics.AddError("Error reading the "+r.kind.noun+" order", err.Error())
+		return
+	}
+
+	current := make([]string, 0, len(listed))
+	for _, id := range liveOrderPrefix(listed, rules) {
+		current = append(current, strconv.Itoa(id))
+	}
+	currentIds, diags := types.ListValueFrom(ctx, types.StringType, current)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(r.kind.attribute), currentIds)...)
+}
+
+func (r *orderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var ids types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(r.kind.attribute), &ids)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.applyOrder(ctx, ids, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(r.kind.attribute), ids)...)
+}
+
+// Delete only removes the order from the state, the rules keep their positions.
+func (r *orderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Removing the "+r.kind.noun+" order from the state, the order in Zendesk is left as it is")
+}
+
+// applyOrder pushes the full order: the listed rules first, followed by the other rules in their current order.
+func (r *orderResource) applyOrder(ctx context.Context, ids types.List, diags *diag.Diagnostics) {
+	listed, listDiags := orderIds(ctx, ids)
+	diags.Append(listDiags...)
+	if diags.HasError() {
+		return
+	}
+
+	rules, err := r.kind.list(ctx, r.client)
+	if err != nil {
+		tflog.Error(ctx, "Error reading the "+r.kind.noun+" order: ", map[string]interface{}{"error": err})
+		diags.AddError("Error reading the "+r.kind.noun+" order", err.Error())
+		return
+	}
+
+	order := fullOrder(listed, rules)
+	tflog.Debug(ctx, "Reorder "+pluralNoun(r.kind.noun), map[string]interface{}{"ids": order})
+	r.kind.reorder(ctx, r.client, order, diags)
+}
+
+func orderIds(ctx context.Context, ids types.List) ([]int, diag.Diagnostics) {
+	var elements []string
+	diags := ids.ElementsAs(ctx, &elements, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+	listed := make([]int, 0, len(elements))
+	for _, element := range elements {
+		id, err := strconv.Atoi(element)
+		if err != nil {
+			diags.AddError("Invalid Id", fmt.Sprintf("%q is not a numeric id", element))
+			continue
+		}
+		listed = append(listed, id)
+	}
+	return listed, diags
+}
+
+// fullOrder returns the listed ids followed by the ids of the other rules in the order of their positions.
+func fullOrder(listed []int, rules []positionedRule) []int {
+	isListed := make(map[int]bool, len(listed))
+	for _, id := range listed {
+		isListed[id] = true
+	}
+	order := append([]int{}, listed...)
+	for _, rule := range sortRulesByPosition(rules) {
+		if !isListed[rule.id] {
+			order = append(order, rule.id)
+		}
+	}
+	return order
+}
+
+// liveOrderPrefix returns the first rules of the live order, as many as the listed ids which still exist. It equals
+// the listed ids as long as nobody changed the order, and shows the rules which moved in between otherwise.
+func liveOrderPrefix(listed []int, rules []positionedRule) []int {
+	exists := make(map[int]bool, len(rules))
+	for _, rule := range rules {
+		exists[rule.id] = true
+	}
+	count := 0
+	for _, id := range listed {
+		if exists[id] {
+			count++
+		}
+	}
+
+	prefix := make([]int, 0, count)
+	for _, rule := range sortRulesByPosition(rules)[:count] {
+		prefix = append(prefix, rule.id)
+	}
+	return prefix
+}
+
+func sortRulesByPosition(rules []positionedRule) []positionedRule {
+	sorted := append([]positionedRule{}, rules...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return intPosition(sorted[i].position) < intPosition(sorted[j].position)
+	})
+	return sorted
+}
+
+func listTriggerPositions(ctx context.Context, client *zendesk_api.SupportApi) ([]positionedRule, error) {
+	rules := make([]positionedRule, 0)
+	err := client.ForEachTrigger(ctx, nil, func(trigger zendesk_api.TriggerObject) bool {
+		if trigger.Id != nil {
+			rules = append(rules, positionedRule{id: *trigger.Id, position: trigger.Position})
+		}
+		return true
+	})
+	return rules, err
+}
+
+func reorderTriggers(ctx context.Context, client *zendesk_api.SupportApi, ids []int, diags *diag.Diagnostics) {
+	reqEditors := make([]zendesk_api.RequestEditorFn, 0)
+	response, err := client.GetClient().ReorderTriggersWithResponse(ctx, zendesk_api.ReorderTriggersJSONRequestBody{TriggerIds: ids}, reqEditors...)
+	if err != nil {
+		tflog.Error(ctx, "Error reordering triggers: ", map[string]interface{}{"error": err})
+		diags.AddError("Error reordering triggers", err.Error())
+		return
+	}
+	if response.StatusCode() == http.StatusOK {
+		return
+	}
+	tflog.Error(ctx, "API error reordering triggers: "+response.Status(), map[string]interface{}{"error": string(response.Body)})
+	if strings.Contains(string(response.Body), "LimitOneCategory") {
+		diags.AddError("Error reordering triggers",
+			"Zendesk only reorders the triggers of accounts with one trigger category. "+
+				"Order the triggers of accounts with several categories with zendesk_trigger_category_order instead.")
+		return
+	}
+	addAPIError(diags, "Error reordering triggers", response.HTTPResponse, response.Body, nil)
+}
+
+func listSLAPolicyPositions(ctx context.Context, client *zendesk_api.SupportApi) ([]positionedRule, error) {
+	rules := make([]positionedRule, 0)
+	err := client.ForEachSLAPolicy(ctx, func(policy zendesk_api.SLAPolicyObject) bool {
+		if policy.Id != nil {
+			rules = append(rules, positionedRule{id: *policy.Id, position: policy.Position})
+		}
+		return true
+	})
+	return rules, err
+}
+
+func reorderSLAPolicies(ctx context.Context, client *zendesk_api.SupportApi, ids []int, diags *diag.Diagnostics) {
+	reqEditors := make([]zendesk_api.RequestEditorFn, 0)
+	// The raw response is read, as the generated parser fails on the answer when it is not the documented empty string
+	response, err := client.GetClient().ReorderSLAPolicies(ctx, zendesk_api.ReorderSLAPoliciesJSONRequestBody{SlaPolicyIds: ids}, reqEditors...)
+	if err != nil {
+		tflog.Error(ctx, "Error reordering SLA policies: ", map[string]interface{}{"error": err})
+		diags.AddError("Error reordering SLA policies", err.Error())
+		return
+	}
+	defer func() { _ = response.Body.Close() }()
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		diags.AddError("Error reordering SLA policies", err.Error())
+		return
+	}
+	if response.StatusCode != http.StatusOK {
+		tflog.Error(ctx, "API error reordering SLA policies: "+response.Status, map[string]interface{}{"error": string(body)})
+		addAPIError(diags, "Error reordering SLA policies", response, body, nil)
+	}
+}
+
+func pluralNoun(noun string) string {
+	if strings.HasSuffix(noun, "y") {
+		return strings.TrimSuffix(noun, "y") + "ies"
+	}
+	return noun + "s"
+}
@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func positionedRules(idsByPosition ...int) []positionedRule {
+	rules := make([]positionedRule, 0, len(idsByPosition))
+	// the API does not list the rules in the order of their positions
+	for i := len(idsByPosition) - 1; i >= 0; i-- {
+		position := i + 1
+		rules = append(rules, positionedRule{id: idsByPosition[i], position: &position})
+	}
+	return rules
+}
+
+func TestFullOrder(t *testing.T) {
+	assert.DeepEqual(t, fullOrder([]int{30, 10}, positionedRules(10, 20, 30, 40)), []int{30, 10, 20, 40})
+	assert.DeepEqual(t, fullOrder([]int{10, 20}, positionedRules(10, 20)), []int{10, 20})
+}
+
+func TestLiveOrderPrefix(t *testing.T) {
+	tests := []struct {
+		name   string
+		listed []int
+		rules  []positionedRule
+		want   []int
+	}{
+		{name: "unchanged", listed: []int{30, 10}, rules: positionedRules(30, 10, 20), want: []int{30, 10}},
+		{name: "reordered", listed: []int{30, 10}, rules: positionedRules(10, 30, 20), want: []int{10, 30}},
+		{name: "other rule moved in between", listed: []int{30, 10}, rules: positionedRules(30, 20, 10), want: []int{30, 20}},
+		{name: "listed rule deleted", listed: []int{30, 10}, rules: positionedRules(10, 20), want: []int{10}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.DeepEqual(t, liveOrderPrefix(tt.listed, tt.rules), tt.want)
+		})
+	}
+}
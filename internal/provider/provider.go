@@ -5,16 +5,19 @@ package provider
 
 import (
 	"context"
-	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"strings"
+	"terraform-provider-zendesk/internal/provider_config"
 	"terraform-provider-zendesk/zendesk_api"
+	"terraform-provider-zendesk/zendesk_http"
 	"terraform-provider-zendesk/zendesk_webhook_api"
 )
 
@@ -32,13 +35,32 @@ type zendeskProvider struct {
 type zendeskProviderData struct {
 	supportApi *zendesk_api.SupportApi
 	webhookApi *zendesk_webhook_api.WebhookApi
+	// rateLimiter throttles all API calls of the provider, nil when requests_per_minute is not set
+	rateLimiter *zendesk_http.RateLimiter
+	// currentUser is the authenticated user, nil when validate_credentials is false
+	currentUser *zendeskUser
+	// accountFeatures are the features of the account plan, nil when they could not be read
+	accountFeatures *zendesk_api.AccountSettingsActiveFeaturesObject
+	// triggerDefinitions are the trigger conditions and actions of the account, read on the first trigger plan
+	triggerDefinitions *triggerDefinitionsCache
 }
 
 // zendeskProviderModel maps provider schema data to a Go type.
 type zendeskProviderModel struct {
-	Account types.String `tfsdk:"account"`
-	Email   types.String `tfsdk:"email"`
-	Token   types.String `tfsdk:"token"`
+	Account             types.String `tfsdk:"account"`
+	ApiUrl              types.String `tfsdk:"api_url"`
+	Email               types.String `tfsdk:"email"`
+	Token               types.String `tfsdk:"token"`
+	OAuthToken          types.String `tfsdk:"oauth_token"`
+	OAuthClientId       types.String `tfsdk:"oauth_client_id"`
+	OAuthSecret         types.String `tfsdk:"oauth_client_secret"`
+	OAuthScope          types.String `tfsdk:"oauth_scope"`
+	MaxRetries          types.Int64  `tfsdk:"max_retries"`
+	RetryMaxWait        types.Int64  `tfsdk:"retry_max_wait"`
+	RequestsPerMinute   types.Int64  `tfsdk:"requests_per_minute"`
+	Profile             types.String `tfsdk:"profile"`
+	CredentialsFile     types.String `tfsdk:"credentials_file"`
+	ValidateCredentials types.Bool   `tfsdk:"validate_credentials"`
 }
 
 func (p *zendeskProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -52,18 +74,67 @@ func (p *zendeskProvider) Schema(ctx context.Context, req provider.SchemaRequest
 		Description: "",
 		Attributes: map[string]schema.Attribute{
 			"account": schema.StringAttribute{
-				Description: "Account name of your Zendesk instance.",
+				Description: provider_config.AccountDescription,
+				Optional:    true,
+			},
+			"api_url": schema.StringAttribute{
+				Description: provider_config.ApiUrlDescription,
 				Optional:    true,
 			},
 			"email": schema.StringAttribute{
-				Description: "Email address of agent user who have permission to access the API.",
+				Description: provider_config.EmailDescription,
 				Optional:    true,
 			},
 			"token": schema.StringAttribute{
-				Description: "[API token](https://developer.zendesk.com/rest_api/docs/support/introduction#api-token) for your Zendesk instance.",
+				Description: provider_config.TokenDescription,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"oauth_token": schema.StringAttribute{
+				Description: provider_config.OAuthTokenDescription,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"oauth_client_id": schema.StringAttribute{
+				Description: provider_config.OAuthClientIdDescription,
+				Optional:    true,
+			},
+			"oauth_client_secret": schema.StringAttribute{
+				Description: provider_config.OAuthSecretDescription,
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"oauth_scope": schema.StringAttribute{
+				Description: provider_config.OAuthScopeDescription,
+				Optional:    true,
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: provider_config.MaxRetriesDescription,
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, zendesk_http.MaxRetriesLimit),
+				},
+			},
+			"retry_max_wait": schema.Int64Attribute{
+				Description: provider_config.RetryMaxWaitDescription,
+				Optional:    true,
+			},
+			"requests_per_minute": schema.Int64Attribute{
+				Description: provider_config.RequestsPerMinuteDescription,
+				Optional:    true,
+			},
+			"profile": schema.StringAttribute{
+				Description: provider_config.ProfileDescription,
+				Optional:    true,
+			},
+			"credentials_file": schema.StringAttribute{
+				Description: provider_config.CredentialsFileDescription,
+				Optional:    true,
+			},
+			"validate_credentials": schema.BoolAttribute{
+				Description: provider_config.ValidateCredentialsDescription,
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -93,6 +164,17 @@ func (p *zendeskProvider) Configure(ctx context.Context, req provider.ConfigureR
 		)
 	}
 
+	if config.ApiUrl.IsUnknown() {
+		errorSummary := "Unknown Zendesk API URL"
+		tflog.Error(ctx, errorSummary)
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_url"),
+			errorSummary,
+			"The provider cannot create the Zendesk API client as there is an unknown configuration value for the Zendesk API URL. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the ZENDESK_API_URL environment variable.",
+		)
+	}
+
 	if config.Email.IsUnknown() {
 		errorSummary := "Unknown Zendesk API Email"
 		tflog.Error(ctx, errorSummary)
@@ -115,36 +197,101 @@ func (p *zendeskProvider) Configure(ctx context.Context, req provider.ConfigureR
 		)
 	}
 
-	if resp.Diagnostics.HasError() {
-		return
+	if config.OAuthToken.IsUnknown() || config.OAuthClientId.IsUnknown() || config.OAuthSecret.IsUnknown() || config.OAuthScope.IsUnknown() {
+		errorSummary := "Unknown Zendesk OAuth Credentials"
+		tflog.Error(ctx, errorSummary)
+		resp.Diagnostics.AddError(
+			errorSummary,
+			"The provider cannot create the Zendesk API client as there is an unknown configuration value for the OAuth credentials. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the ZENDESK_OAUTH_* environment variables.",
+		)
 	}
 
-	var hostUrl, email, apiToken string
+	if config.MaxRetries.IsUnknown() || config.RetryMaxWait.IsUnknown() || config.RequestsPerMinute.IsUnknown() {
+		errorSummary := "Unknown Zendesk API Retry Settings"
+		tflog.Error(ctx, errorSummary)
+		resp.Diagnostics.AddError(
+			errorSummary,
+			"The provider cannot create the Zendesk API client as there is an unknown configuration value for max_retries, retry_max_wait or requests_per_minute. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
 
-	if !config.Account.IsNull() {
-		baseURLFormat := "https://%s.zendesk.com"
-		if strings.Contains(config.Account.ValueString(), "localhost") {
-			hostUrl = "http://localhost:"
-			if strings.Contains(config.Account.ValueString(), "Port") {
-				hostUrl += strings.Replace(config.Account.ValueString(), "localhostPort", "", 1)
-			} else {
-				hostUrl += "8080"
-			}
-		} else {
-			hostUrl = fmt.Sprintf(baseURLFormat, config.Account.ValueString())
-		}
+	if config.Profile.IsUnknown() || config.CredentialsFile.IsUnknown() {
+		errorSummary := "Unknown Zendesk Credentials Profile"
+		tflog.Error(ctx, errorSummary)
+		resp.Diagnostics.AddError(
+			errorSummary,
+			"The provider cannot read the Zendesk credentials file as there is an unknown configuration value for the profile or the credentials file. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the ZENDESK_PROFILE and ZENDESK_CREDENTIALS_FILE environment variables.",
+		)
+	}
 
-		tflog.Debug(ctx, "Used account from configuration")
+	if config.ValidateCredentials.IsUnknown() {
+		errorSummary := "Unknown Zendesk Credential Validation Setting"
+		tflog.Error(ctx, errorSummary)
+		resp.Diagnostics.AddAttributeError(
+			path.Root("validate_credentials"),
+			errorSummary,
+			"The provider cannot decide whether to validate the credentials as there is an unknown configuration value for validate_credentials. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if !config.Email.IsNull() {
-		email = config.Email.ValueString()
-		tflog.Debug(ctx, "Used email from configuration")
+	credentials, err := provider_config.ResolveCredentials(provider_config.ExplicitCredentials{
+		Account:         config.Account.ValueString(),
+		ApiUrl:          config.ApiUrl.ValueString(),
+		Email:           config.Email.ValueString(),
+		Token:           config.Token.ValueString(),
+		OAuthToken:      config.OAuthToken.ValueString(),
+		OAuthClientId:   config.OAuthClientId.ValueString(),
+		OAuthSecret:     config.OAuthSecret.ValueString(),
+		OAuthScope:      config.OAuthScope.ValueString(),
+		Profile:         config.Profile.ValueString(),
+		CredentialsFile: config.CredentialsFile.ValueString(),
+	})
+	if err != nil {
+		tflog.Error(ctx, "Error reading Zendesk credentials", map[string]any{"error": err.Error()})
+		resp.Diagnostics.AddAttributeError(
+			path.Root("credentials_file"),
+			"Invalid Zendesk Credentials File",
+			"The provider cannot read the Zendesk credentials file: "+err.Error(),
+		)
+		return
 	}
 
-	if !config.Token.IsNull() {
-		apiToken = config.Token.ValueString()
-		tflog.Debug(ctx, "Used token from configuration")
+	tflog.Info(ctx, "Resolved Zendesk credentials", map[string]any{
+		"sources":     credentials.Sources(),
+		"auth_method": credentials.AuthMethod(),
+	})
+	summary, detail := credentialSourcesWarning(credentials)
+	resp.Diagnostics.AddWarning(summary, detail)
+
+	if authMethods := credentials.ConfiguredAuthMethods(); len(authMethods) > 1 {
+		resp.Diagnostics.AddWarning(
+			"Several Zendesk Authentication Methods Configured",
+			"Values were found for "+strings.Join(authMethods, ", ")+" authentication, the provider uses "+credentials.AuthMethod()+". "+
+				"Remove the values of the other methods to silence this warning.",
+		)
+	}
+
+	hostUrl, err := credentials.ServerUrl()
+	if err != nil {
+		tflog.Error(ctx, "Invalid Zendesk API URL", map[string]any{"error": err.Error()})
+		attributePath := path.Root("account")
+		if credentials.ApiUrl.Value != "" {
+			attributePath = path.Root("api_url")
+		}
+		resp.Diagnostics.AddAttributeError(
+			attributePath,
+			"Invalid Zendesk API URL",
+			"The provider cannot create the Zendesk API client: "+err.Error(),
+		)
+		return
 	}
 
 	// If any of the expected configurations are missing, return
@@ -156,30 +303,43 @@ func (p *zendeskProvider) Configure(ctx context.Context, req provider.ConfigureR
 			path.Root("account"),
 			"Missing Zendesk API Account",
 			"The provider cannot create the Zendesk API client as there is a missing or empty value for the Zendesk API account. "+
-				"Set the account value in the configuration or use the ZENDESK_ACCOUNT environment variable. "+
-				"If either is already set, ensure the value is not empty.",
+				"Set the account value in the configuration, use the ZENDESK_ACCOUNT environment variable or add it to the credentials file profile. "+
+				"Alternatively set the api_url of the Zendesk API. "+
+				"If any is already set, ensure the value is not empty.",
 		)
 	}
 
-	if email == "" {
+	if credentials.AuthMethod() == provider_config.AuthMethodAPIToken && credentials.Email.Value == "" {
 		tflog.Error(ctx, "Missing Zendesk API Email")
 		resp.Diagnostics.AddAttributeError(
 			path.Root("email"),
 			"Missing Zendesk API Email",
 			"The provider cannot create the Zendesk API client as there is a missing or empty value for the Zendesk API email. "+
-				"Set the email value in the configuration or use the ZENDESK_EMAIL environment variable. "+
-				"If either is already set, ensure the value is not empty.",
+				"Set the email value in the configuration, use the ZENDESK_EMAIL environment variable or add it to the credentials file profile. "+
+				"Alternatively authenticate with OAuth by setting oauth_token or oauth_client_id and oauth_client_secret. "+
+				"If any is already set, ensure the value is not empty.",
 		)
 	}
 
-	if apiToken == "" {
+	if credentials.AuthMethod() == provider_config.AuthMethodAPIToken && credentials.Token.Value == "" {
 		tflog.Error(ctx, "Missing Zendesk API Token")
 		resp.Diagnostics.AddAttributeError(
 			path.Root("token"),
 			"Missing Zendesk API Token",
 			"The provider cannot create the Zendesk API client as there is a missing or empty value for the Zendesk API token. "+
-				"Set the token value in the configuration or use the ZENDESK_TOKEN environment variable. "+
-				"If either is already set, ensure the value is not empty.",
+				"Set the token value in the configuration, use the ZENDESK_TOKEN environment variable or add it to the credentials file profile. "+
+				"Alternatively authenticate with OAuth by setting oauth_token or oauth_client_id and oauth_client_secret. "+
+				"If any is already set, ensure the value is not empty.",
+		)
+	}
+
+	if credentials.AuthMethod() == provider_config.AuthMethodClientCredentials && (credentials.OAuthClientId.Value == "" || credentials.OAuthSecret.Value == "") {
+		tflog.Error(ctx, "Incomplete Zendesk OAuth Client Credentials")
+		resp.Diagnostics.AddAttributeError(
+			path.Root("oauth_client_secret"),
+			"Incomplete Zendesk OAuth Client Credentials",
+			"The provider cannot exchange the OAuth client credentials as only one of oauth_client_id and oauth_client_secret is set. "+
+				"Set both in the configuration, with the ZENDESK_OAUTH_CLIENT_ID and ZENDESK_OAUTH_CLIENT_SECRET environment variables or in the credentials file profile.",
 		)
 	}
 
@@ -187,36 +347,109 @@ func (p *zendeskProvider) Configure(ctx context.Context, req provider.ConfigureR
 		return
 	}
 
+	httpSettings := provider_config.HttpSettings{
+		MaxRetries:        config.MaxRetries.ValueInt64Pointer(),
+		RetryMaxWait:      config.RetryMaxWait.ValueInt64Pointer(),
+		RequestsPerMinute: config.RequestsPerMinute.ValueInt64Pointer(),
+	}
+	if err := httpSettings.Validate(); err != nil {
+		tflog.Error(ctx, "Invalid Zendesk API Retry Settings", map[string]any{"error": err.Error()})
+		resp.Diagnostics.AddError("Invalid Zendesk API Retry Settings", err.Error())
+		return
+	}
+	rateLimiter := httpSettings.SharedRateLimiter(hostUrl)
+	httpClient := httpSettings.NewHttpClient(rateLimiter)
+
+	authenticator, err := credentials.NewAuthenticator(ctx, httpClient, hostUrl)
+	if err != nil {
+		tflog.Error(ctx, "Error authenticating with the Zendesk OAuth client credentials", map[string]any{"error": err.Error()})
+		resp.Diagnostics.AddAttributeError(
+			path.Root("oauth_client_id"),
+			"Zendesk OAuth Client Credentials Exchange Failed",
+			"The provider cannot obtain an OAuth access token for the configured OAuth client: "+err.Error(),
+		)
+		return
+	}
+
 	tflog.Debug(ctx, "Creating Zendesk API clients")
+	supportApi, err := zendesk_api.NewSupportApi(hostUrl, authenticator, httpClient)
+	if err != nil {
+		tflog.Error(ctx, "Error creating the Zendesk Support API client", map[string]any{"error": err.Error()})
+		resp.Diagnostics.AddError(
+			"Unable to Create Zendesk API Client",
+			"An unexpected error occurred when creating the Zendesk Support API client: "+err.Error(),
+		)
+		return
+	}
+	webhookApi, err := zendesk_webhook_api.NewWebhookApi(hostUrl, authenticator, httpClient)
+	if err != nil {
+		tflog.Error(ctx, "Error creating the Zendesk Webhook API client", map[string]any{"error": err.Error()})
+		resp.Diagnostics.AddError(
+			"Unable to Create Zendesk API Client",
+			"An unexpected error occurred when creating the Zendesk Webhook API client: "+err.Error(),
+		)
+		return
+	}
+
 	// Make the Zendesk clients available during DataSource and Resource
-	// type Configure methods.*/
+	// type Configure methods.
 	providerData := zendeskProviderData{
-		supportApi: zendesk_api.NewSupportApi(
-			hostUrl,
-			email,
-			apiToken,
-		),
-		webhookApi: zendesk_webhook_api.NewWebhookApi(
-			hostUrl,
-			email,
-			apiToken,
-		)}
+		supportApi:         supportApi,
+		webhookApi:         webhookApi,
+		rateLimiter:        rateLimiter,
+		triggerDefinitions: &triggerDefinitionsCache{},
+	}
+
+	if config.ValidateCredentials.IsNull() || config.ValidateCredentials.ValueBool() {
+		providerData.currentUser = validateCredentials(ctx, supportApi, credentials, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		providerData.accountFeatures = readAccountFeatures(ctx, supportApi)
+	}
 
 	resp.DataSourceData = providerData
 	resp.ResourceData = providerData
 	tflog.Info(ctx, "Zendesk Provider configured successfully")
 }
 
+// credentialSourcesWarning tells which source supplied each credential value, so a value picked up from an
+// unexpected environment variable or profile is noticed before it is used.
+func credentialSourcesWarning(credentials *provider_config.Credentials) (string, string) {
+	if credentials.HasMixedSources() {
+		return "Zendesk Credentials Combined From Several Sources",
+			"The provider credentials were resolved from different sources: " + credentials.SourceSummary() + ". " +
+				"Make sure this is intended, values set in the configuration take precedence over environment variables, " +
+				"which take precedence over the credentials file."
+	}
+	return "Zendesk Credential Sources",
+		"The provider credentials were resolved from: " + credentials.SourceSummary() + "."
+}
+
 func (p *zendeskProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewCustomStatusResource,
 		NewWebhookResource,
+		NewWebhookSigningSecretRotationResource,
+		NewTriggerResource,
+		NewTriggerCategoryResource,
+		NewTriggerCategoryOrderResource,
+		NewTriggerOrderResource,
+		NewSLAPolicyOrderResource,
+		NewViewResource,
 	}
 }
 
 func (p *zendeskProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	// no data sources yet
-	return nil
+	return []func() datasource.DataSource{
+		NewWebhookDataSource,
+		NewWebhookSigningSecretDataSource,
+		NewWebhookTestDataSource,
+		NewWebhookInvocationsDataSource,
+		NewTriggerRevisionsDataSource,
+		NewViewCountDataSource,
+		NewViewTicketsDataSource,
+	}
 }
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -4,8 +4,13 @@
 package provider
 
 import (
+	"strings"
+	"testing"
+
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"gotest.tools/v3/assert"
 	"terraform-provider-zendesk/internal/combined_provider"
+	"terraform-provider-zendesk/internal/provider_config"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -15,7 +20,10 @@ import (
 var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
 	"zendesk": func() (tfprotov6.ProviderServer, error) {
 		server, err := combined_provider.BuildMuxProviderServer(New("test")())
-		return *server, err
+		if err != nil {
+			return nil, err
+		}
+		return *server, nil
 	},
 }
 
@@ -29,9 +37,26 @@ const (
 	// test configuration so the Zendesk client is properly configured.
 	providerConfig = `
 provider "zendesk" {
-  email = "education"
-  token = "test123"
-  account     = "localhost"
+  email   = "education"
+  token   = "test123"
+  api_url = "http://localhost:8080"
+
+  validate_credentials = false
 }
 `
 )
+
+func TestCredentialSourcesWarning(t *testing.T) {
+	credentials := &provider_config.Credentials{
+		Account: provider_config.ResolvedValue{Value: "example", Source: "provider configuration"},
+		Email:   provider_config.ResolvedValue{Value: "admin@example.com", Source: "provider configuration"},
+	}
+	summary, detail := credentialSourcesWarning(credentials)
+	assert.Equal(t, summary, "Zendesk Credential Sources")
+	assert.Equal(t, detail, "The provider credentials were resolved from: account from provider configuration, email from provider configuration.")
+
+	credentials.Token = provider_config.ResolvedValue{Value: "t", Source: "environment variable ZENDESK_TOKEN"}
+	summary, detail = credentialSourcesWarning(credentials)
+	assert.Equal(t, summary, "Zendesk Credentials Combined From Several Sources")
+	assert.Assert(t, strings.Contains(detail, "token from environment variable ZENDESK_TOKEN"), detail)
+}
@@ -0,0 +1,21 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// removeResourceWhenNotFound removes the resource from the state, when Zendesk answered a read with 404 because
+// the resource was deleted outside of Terraform. Terraform then plans to create it again. The caller stops
+// reading when it returns true.
+func removeResourceWhenNotFound(ctx context.Context, statusCode int, resourceType string, id string, resp *resource.ReadResponse) bool {
+	if statusCode != http.StatusNotFound {
+		return false
+	}
+	tflog.Warn(ctx, "Resource not found in Zendesk, removing it from the state", map[string]any{"resource": resourceType, "id": id})
+	resp.State.RemoveResource(ctx)
+	return true
+}
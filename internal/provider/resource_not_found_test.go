@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"gotest.tools/v3/assert"
+)
+
+func TestRemoveResourceWhenNotFound(t *testing.T) {
+	tests := []struct {
+		name        string
+		statusCode  int
+		wantRemoved bool
+	}{
+		{name: "deleted outside of Terraform", statusCode: http.StatusNotFound, wantRemoved: true},
+		{name: "found", statusCode: http.StatusOK},
+		{name: "server error", statusCode: http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			resourceSchema := schema.Schema{Attributes: map[string]schema.Attribute{"id": schema.StringAttribute{Computed: true}}}
+			resp := &resource.ReadResponse{State: tfsdk.State{Schema: resourceSchema,
+				Raw: tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), nil)}}
+			assert.Assert(t, !resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue("1")).HasError())
+
+			removed := removeResourceWhenNotFound(ctx, tt.statusCode, "zendesk_test", "1", resp)
+
+			assert.Equal(t, removed, tt.wantRemoved)
+			assert.Equal(t, resp.State.Raw.IsNull(), tt.wantRemoved)
+		})
+	}
+}
@@ -0,0 +1,460 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"math"
+	"net/http"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"terraform-provider-zendesk/zendesk_api"
+)
+
+var (
+	_ resource.Resource                   = (*triggerCategoryOrderResource)(nil)
+	_ resource.ResourceWithConfigure      = (*triggerCategoryOrderResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*triggerCategoryOrderResource)(nil)
+)
+
+func NewTriggerCategoryOrderResource() resource.Resource {
+	return &triggerCategoryOrderResource{}
+}
+
+type triggerCategoryOrderResource struct {
+	client *zendesk_api.SupportApi
+}
+
+type triggerCategoryOrderResourceModel struct {
+	Category []triggerCategoryPlacementModel `tfsdk:"category"`
+}
+
+type triggerCategoryPlacementModel struct {
+	Id         types.String `tfsdk:"id"`
+	TriggerIds types.List   `tfsdk:"trigger_ids"`
+}
+
+// triggerCategoryPlacement is a category of the order with the triggers placed in it. The triggers of the category
+// are left as they are when placesTriggers is false.
+type triggerCategoryPlacement struct {
+	id             string
+	triggerIds     []string
+	placesTriggers bool
+}
+
+// triggerCategoryJobRequest is the body of the trigger categories batch job. The generated request type declares
+// the job as an anonymous struct.
+type triggerCategoryJobRequest struct {
+	Job triggerCategoryJob `json:"job"`
+}
+
+type triggerCategoryJob struct {
+	Action zendesk_api.BatchJobRequestJobAction `json:"action"`
+	Items  triggerCategoryJobItems              `json:"items"`
+}
+
+type triggerCategoryJobItems struct {
+	TriggerCategories []zendesk_api.TriggerCategoryBatchRequest `json:"trigger_categories"`
+	Triggers          []zendesk_api.TriggerBatchRequest         `json:"triggers"`
+}
+
+func (r *triggerCategoryOrderResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_trigger_category_order"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *triggerCategoryOrderResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := request.ProviderData.(zendeskProviderData)
+
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *zendeskProviderData, got: %T. Please report this issue to the provider developers.",
+				request.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.supportApi
+}
+
+func (r *triggerCategoryOrderResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Orders the trigger categories and places triggers in them, in one batch job of Zendesk. " +
+			"The categories of the blocks come first, in the order of the blocks, followed by the other categories of the account. " +
+			"The placed triggers of a category come first in it, followed by its other triggers. " +
+			"Do not also order the placed triggers with `zendesk_trigger_order`, both write the trigger positions and undo each other on every apply. " +
+			"Destroying the resource leaves the order as it is.",
+		Blocks: map[string]schema.Block{
+			"category": schema.ListNestedBlock{
+				Description: "A trigger category, in the order the categories run in.",
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Id of the trigger category.",
+							Required:    true,
+						},
+						"trigger_ids": schema.ListAttribute{
+							Description: "Ids of the triggers to move into the category, in the order they run in. " +
+								"The triggers of the category are left as they are when not set.",
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *triggerCategoryOrderResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config triggerCategoryOrderResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	categoryIds := make(map[string]bool)
+	triggerIds := make(map[string]bool)
+	for i, category := range config.Category {
+		categoryPath := path.Root("category").AtListIndex(i)
+		if !category.Id.IsUnknown() {
+			if categoryIds[category.Id.ValueString()] {
+				resp.Diagnostics.AddAttributeError(categoryPath.AtName("id"), "Duplicate Trigger Category",
+					fmt.Sprintf("The trigger category %q is ordered more than once.", category.Id.ValueString()))
+			}
+			categoryIds[category.Id.ValueString()] = true
+		}
+		if category.TriggerIds.IsNull() || category.TriggerIds.IsUnknown() {
+			continue
+		}
+		for _, element := range category.TriggerIds.Elements() {
+			triggerId, ok := element.(types.String)
+			if !ok || triggerId.IsNull() || triggerId.IsUnknown() {
+				continue
+			}
+			if triggerIds[triggerId.ValueString()] {
+				resp.Diagnostics.AddAttributeError(categoryPath.AtName("trigger_ids"), "Duplicate Trigger",
+					fmt.Sprintf("The trigger %q is placed more than once.", triggerId.ValueString()))
+			}
+			triggerIds[triggerId.ValueString()] = true
+		}
+	}
+}
+
+func (r *triggerCategoryOrderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan triggerCategoryOrderResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyOrder(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *triggerCategoryOrderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state triggerCategoryOrderResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	placements, diags := triggerCategoryPlacements(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	categories, triggers, err := r.listCategoriesAndTriggers(ctx)
+	if err != nil {
+		tflog.Error(ctx, "Error reading trigger category order: ", map[string]interface{}{"error": err})
+		resp.Diagnostics.AddError("Error reading trigger category order", err.Error())
+		return
+	}
+
+	state.Category = make([]triggerCategoryPlacementModel, 0, len(placements))
+	for _, placement := range currentTriggerCategoryPlacements(placements, categories, triggers) {
+		triggerIds := types.ListNull(types.StringType)
+		if placement.placesTriggers {
+			var listDiags diag.Diagnostics
+			triggerIds, listDiags = types.ListValueFrom(ctx, types.StringType, placement.triggerIds)
+			resp.Diagnostics.Append(listDiags...)
+		}
+		state.Category = append(state.Category, triggerCategoryPlacementModel{
+			Id:         types.StringValue(placement.id),
+			TriggerIds: triggerIds,
+		})
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *triggerCategoryOrderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan triggerCategoryOrderResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyOrder(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the order from the state, the categories and triggers keep their positions.
+func (r *triggerCategoryOrderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Removing the trigger category order from the state, the order in Zendesk is left as it is")
+}
+
+// applyOrder pushes the order of the categories and the placement of the triggers in one batch job.
+func (r *triggerCategoryOrderResource) applyOrder(ctx context.Context, model triggerCategoryOrderResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	placements, placementDiags := triggerCategoryPlacements(ctx, model)
+	diags.Append(placementDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	categories, triggers, err := r.listCategoriesAndTriggers(ctx)
+	if err != nil {
+		tflog.Error(ctx, "Error reading trigger categories and triggers: ", map[string]interface{}{"error": err})
+		diags.AddError("Error reading trigger categories and triggers", err.Error())
+		return diags
+	}
+
+	body, err := json.Marshal(triggerCategoryJobRequest{Job: triggerCategoryOrderJob(placements, categories, triggers)})
+	if err != nil {
+		diags.AddError("Error mapping trigger category order to the API request", err.Error())
+		return diags
+	}
+
+	tflog.Debug(ctx, "Order trigger categories", map[string]interface{}{"categories": len(placements)})
+	reqEditors := make([]zendesk_api.RequestEditorFn, 0)
+	response, err := r.client.GetClient().BatchOperateTriggerCategoriesWithBodyWithResponse(ctx, "application/json", bytes.NewReader(body), reqEditors...)
+	if err != nil {
+		tflog.Error(ctx, "Error ordering trigger categories: ", map[string]interface{}{"error": err})
+		diags.AddError("Error ordering trigger categories", err.Error())
+		return diags
+	}
+	if response.StatusCode() != http.StatusOK || response.JSON200 == nil {
+		tflog.Error(ctx, "API error ordering trigger categories: "+response.Status(), map[string]interface{}{"error": string(response.Body)})
+		addAPIError(&diags, "Error ordering trigger categories", response.HTTPResponse, response.Body, nil)
+		return diags
+	}
+	if err := triggerCategoryJobError(response.JSON200); err != nil {
+		tflog.Error(ctx, "Trigger category job failed: ", map[string]interface{}{"error": err})
+		diags.AddError("Error ordering trigger categories", err.Error())
+	}
+	return diags
+}
+
+func (r *triggerCategoryOrderResource) listCategoriesAndTriggers(ctx context.Context) ([]zendesk_api.TriggerCategory, []zendesk_api.TriggerObject, error) {
+	categories := make([]zendesk_api.TriggerCategory, 0)
+	err := r.client.ForEachTriggerCategory(ctx, nil, func(category zendesk_api.TriggerCategory) bool {
+		categories = append(categories, category)
+		return true
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	triggers := make([]zendesk_api.TriggerObject, 0)
+	err = r.client.ForEachTrigger(ctx, nil, func(trigger zendesk_api.TriggerObject) bool {
+		triggers = append(triggers, trigger)
+		return true
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return categories, triggers, nil
+}
+
+func triggerCategoryPlacements(ctx context.Context, model triggerCategoryOrderResourceModel) ([]triggerCategoryPlacement, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	placements := make([]triggerCategoryPlacement, 0, len(model.Category))
+	for _, category := range model.Category {
+		placement := triggerCategoryPlacement{id: category.Id.ValueString()}
+		if !category.TriggerIds.IsNull() {
+			placement.placesTriggers = true
+			diags.Append(category.TriggerIds.ElementsAs(ctx, &placement.triggerIds, false)...)
+		}
+		placements = append(placements, placement)
+	}
+	return placements, diags
+}
+
+// triggerCategoryOrderJob builds the job which puts the categories of the placements first, in their order,
+// followed by the other categories in their current order. The triggers of a placement come first in the
+// category, followed by the other triggers of the category in their current order.
+func triggerCategoryOrderJob(placements []triggerCategoryPlacement, categories []zendesk_api.TriggerCategory, triggers []zendesk_api.TriggerObject) triggerCategoryJob {
+	job := triggerCategoryJob{Action: zendesk_api.Patch}
+
+	placedCategories := make(map[string]bool)
+	placedTriggers := make(map[string]bool)
+	for _, placement := range placements {
+		placedCategories[placement.id] = true
+		for _, triggerId := range placement.triggerIds {
+			placedTriggers[triggerId] = true
+		}
+	}
+
+	categoryIds := make([]string, 0, len(categories))
+	for _, placement := range placements {
+		categoryIds = append(categoryIds, placement.id)
+	}
+	for _, category := range sortTriggerCategoriesByPosition(categories) {
+		if category.Id != nil && !placedCategories[*category.Id] {
+			categoryIds = append(categoryIds, *category.Id)
+		}
+	}
+	for i, categoryId := range categoryIds {
+		job.Items.TriggerCategories = append(job.Items.TriggerCategories, zendesk_api.TriggerCategoryBatchRequest{
+			Id:       categoryId,
+			Position: int64(i + 1),
+		})
+	}
+
+	sortedTriggers := sortTriggersByPosition(triggers)
+	for _, placement := range placements {
+		if !placement.placesTriggers {
+			continue
+		}
+		triggerIds := append([]string{}, placement.triggerIds...)
+		for _, trigger := range sortedTriggers {
+			triggerId := triggerIdString(trigger)
+			if trigger.CategoryId != nil && *trigger.CategoryId == placement.id && !placedTriggers[triggerId] {
+				triggerIds = append(triggerIds, triggerId)
+			}
+		}
+		for i, triggerId := range triggerIds {
+			categoryId := placement.id
+			position := int64(i + 1)
+			job.Items.Triggers = append(job.Items.Triggers, zendesk_api.TriggerBatchRequest{
+				Id:         triggerId,
+				CategoryId: &categoryId,
+				Position:   &position,
+			})
+		}
+	}
+	return job
+}
+
+// currentTriggerCategoryPlacements returns the placements as they are in Zendesk: the categories which still exist
+// in the order of their positions, and the placed triggers which are still in the category in the order of theirs.
+func currentTriggerCategoryPlacements(placements []triggerCategoryPlacement, categories []zendesk_api.TriggerCategory, triggers []zendesk_api.TriggerObject) []triggerCategoryPlacement {
+	placementsById := make(map[string]triggerCategoryPlacement)
+	for _, placement := range placements {
+		placementsById[placement.id] = placement
+	}
+
+	sortedTriggers := sortTriggersByPosition(triggers)
+	current := make([]triggerCategoryPlacement, 0, len(placements))
+	for _, category := range sortTriggerCategoriesByPosition(categories) {
+		if category.Id == nil {
+			continue
+		}
+		placement, ok := placementsById[*category.Id]
+		if !ok {
+			continue
+		}
+		currentPlacement := triggerCategoryPlacement{id: placement.id, placesTriggers: placement.placesTriggers}
+		if placement.placesTriggers {
+			currentPlacement.triggerIds = make([]string, 0, len(placement.triggerIds))
+			for _, trigger := range sortedTriggers {
+				triggerId := triggerIdString(trigger)
+				if trigger.CategoryId != nil && *trigger.CategoryId == placement.id && slices.Contains(placement.triggerIds, triggerId) {
+					currentPlacement.triggerIds = append(currentPlacement.triggerIds, triggerId)
+				}
+			}
+		}
+		current = append(current, currentPlacement)
+	}
+	return current
+}
+
+// triggerCategoryJobError returns the errors of a failed batch job.
+func triggerCategoryJobError(response *zendesk_api.BatchJobResponse) error {
+	failed := response.Status != nil && *response.Status == zendesk_api.BatchJobResponseStatusFailed
+	if !failed && (response.Errors == nil || len(*response.Errors) == 0) {
+		return nil
+	}
+	messages := make([]string, 0)
+	if response.Errors != nil {
+		for _, batchError := range *response.Errors {
+			message := batchError.Title
+			if batchError.Detail != nil {
+				message += ": " + *batchError.Detail
+			}
+			if batchError.TriggerId != nil {
+				message += " (trigger " + *batchError.TriggerId + ")"
+			} else if batchError.Id != nil {
+				message += " (" + *batchError.Id + ")"
+			}
+			messages = append(messages, message)
+		}
+	}
+	return fmt.Errorf("the trigger category job failed: %s", strings.Join(messages, "\n"))
+}
+
+func sortTriggerCategoriesByPosition(categories []zendesk_api.TriggerCategory) []zendesk_api.TriggerCategory {
+	sorted := append([]zendesk_api.TriggerCategory{}, categories...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return int64Position(sorted[i].Position) < int64Position(sorted[j].Position)
+	})
+	return sorted
+}
+
+func sortTriggersByPosition(triggers []zendesk_api.TriggerObject) []zendesk_api.TriggerObject {
+	sorted := append([]zendesk_api.TriggerObject{}, triggers...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return intPosition(sorted[i].Position) < intPosition(sorted[j].Position)
+	})
+	return sorted
+}
+
+// int64Position sorts items without a position last.
+func int64Position(position *int64) int64 {
+	if position == nil {
+		return math.MaxInt64
+	}
+	return *position
+}
+
+func intPosition(position *int) int64 {
+	if position == nil {
+		return int64Position(nil)
+	}
+	return int64(*position)
+}
+
+func triggerIdString(trigger zendesk_api.TriggerObject) string {
+	if trigger.Id == nil {
+		return ""
+	}
+	return strconv.Itoa(*trigger.Id)
+}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	"terraform-provider-zendesk/zendesk_api"
+)
+
+func triggerCategoryOrderFixtures(t *testing.T) ([]zendesk_api.TriggerCategory, []zendesk_api.TriggerObject) {
+	var categories []zendesk_api.TriggerCategory
+	assert.NilError(t, json.Unmarshal([]byte(`[
+		{"id": "c3", "position": 3}, {"id": "c1", "position": 1}, {"id": "c2", "position": 2}
+	]`), &categories))
+	var triggers []zendesk_api.TriggerObject
+	assert.NilError(t, json.Unmarshal([]byte(`[
+		{"id": 11, "category_id": "c1", "position": 2, "title": "t11", "actions": [], "conditions": {}},
+		{"id": 10, "category_id": "c1", "position": 1, "title": "t10", "actions": [], "conditions": {}},
+		{"id": 20, "category_id": "c2", "position": 1, "title": "t20", "actions": [], "conditions": {}},
+		{"id": 30, "category_id": "c3", "position": 1, "title": "t30", "actions": [], "conditions": {}}
+	]`), &triggers))
+	return categories, triggers
+}
+
+func TestTriggerCategoryOrderJob(t *testing.T) {
+	categories, triggers := triggerCategoryOrderFixtures(t)
+
+	job := triggerCategoryOrderJob([]triggerCategoryPlacement{
+		{id: "c3"},
+		{id: "c1", triggerIds: []string{"30", "11"}, placesTriggers: true},
+	}, categories, triggers)
+
+	body, err := json.Marshal(triggerCategoryJobRequest{Job: job})
+	assert.NilError(t, err)
+	assert.Equal(t, string(body), `{"job":{"action":"patch","items":{`+
+		`"trigger_categories":[{"id":"c3","position":1},{"id":"c1","position":2},{"id":"c2","position":3}],`+
+		`"triggers":[{"category_id":"c1","id":"30","position":1},{"category_id":"c1","id":"11","position":2},`+
+		`{"category_id":"c1","id":"10","position":3}]}}}`)
+}
+
+func TestCurrentTriggerCategoryPlacements(t *testing.T) {
+	categories, triggers := triggerCategoryOrderFixtures(t)
+
+	current := currentTriggerCategoryPlacements([]triggerCategoryPlacement{
+		{id: "c2"},
+		{id: "c1", triggerIds: []string{"11", "10", "30"}, placesTriggers: true},
+		{id: "deleted"},
+	}, categories, triggers)
+
+	// the triggers placed in c1 which moved to c3 are dropped, the categories follow their positions
+	assert.Equal(t, fmt.Sprintf("%+v", current), "[{id:c1 triggerIds:[10 11] placesTriggers:true} {id:c2 triggerIds:[] placesTriggers:false}]")
+}
+
+func TestTriggerCategoryJobError(t *testing.T) {
+	var response zendesk_api.BatchJobResponse
+	assert.NilError(t, json.Unmarshal([]byte(`{"status": "complete", "results": {}}`), &response))
+	assert.NilError(t, triggerCategoryJobError(&response))
+
+	assert.NilError(t, json.Unmarshal([]byte(`{"status": "failed", "errors": [
+		{"code": "InvalidTrigger", "title": "Invalid trigger", "detail": "Category does not exist", "trigger_id": "30"}
+	]}`), &response))
+	assert.Error(t, triggerCategoryJobError(&response),
+		"the trigger category job failed: Invalid trigger: Category does not exist (trigger 30)")
+}
@@ -0,0 +1,250 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"net/http"
+	"terraform-provider-zendesk/zendesk_api"
+)
+
+var (
+	_ resource.Resource                = (*triggerCategoryResource)(nil)
+	_ resource.ResourceWithConfigure   = (*triggerCategoryResource)(nil)
+	_ resource.ResourceWithImportState = (*triggerCategoryResource)(nil)
+)
+
+func NewTriggerCategoryResource() resource.Resource {
+	return &triggerCategoryResource{}
+}
+
+type triggerCategoryResource struct {
+	client *zendesk_api.SupportApi
+}
+
+type triggerCategoryResourceModel struct {
+	Id        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Position  types.Int64  `tfsdk:"position"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+// triggerCategoryRequest is the body to create and update a trigger category.
+type triggerCategoryRequest struct {
+	TriggerCategory zendesk_api.TriggerCategoryRequest `json:"trigger_category"`
+}
+
+// triggerCategoryAttributePaths maps the fields of validation errors of the trigger category API to the Terraform paths.
+func triggerCategoryAttributePaths(field string) (path.Path, bool) {
+	switch field {
+	case "name", "position":
+		return path.Root(field), true
+	}
+	return path.Empty(), false
+}
+
+func (r *triggerCategoryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_trigger_category"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *triggerCategoryResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := request.ProviderData.(zendeskProviderData)
+
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *zendeskProviderData, got: %T. Please report this issue to the provider developers.",
+				request.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.supportApi
+}
+
+func (r *triggerCategoryResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a trigger category. Triggers run category by category in the order of their positions.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Id of the trigger category.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the trigger category.",
+				Required:    true,
+			},
+			"position": schema.Int64Attribute{
+				Description: "Position of the category among the trigger categories. Zendesk puts new categories last when not set. " +
+					"Leave it unset for categories ordered by a `zendesk_trigger_category_order`.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The time the trigger category was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "The time of the last update of the trigger category.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *triggerCategoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan triggerCategoryResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, err := json.Marshal(mapTriggerCategoryModelToRequest(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Error mapping trigger category to the API request", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Create trigger category", map[string]interface{}{"name": plan.Name.ValueString()})
+	reqEditors := make([]zendesk_api.RequestEditorFn, 0)
+	response, err := r.client.GetClient().CreateTriggerCategoryWithBodyWithResponse(ctx, "application/json", bytes.NewReader(body), reqEditors...)
+	if err != nil {
+		tflog.Error(ctx, "Error creating trigger category: ", map[string]interface{}{"error": err})
+		resp.Diagnostics.AddError("Error creating trigger category", err.Error())
+		return
+	}
+	// Zendesk answers the creation of a category with 200, not 201
+	if response.StatusCode() != http.StatusOK || response.JSON200 == nil || response.JSON200.TriggerCategory == nil {
+		tflog.Error(ctx, "API error creating trigger category: "+response.Status(), map[string]interface{}{"error": string(response.Body)})
+		addAPIError(&resp.Diagnostics, "Error creating trigger category", response.HTTPResponse, response.Body, triggerCategoryAttributePaths)
+		return
+	}
+
+	mapTriggerCategoryToModel(response.JSON200.TriggerCategory, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *triggerCategoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state triggerCategoryResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reqEditors := make([]zendesk_api.RequestEditorFn, 0)
+	response, err := r.client.GetClient().ShowTriggerCategoryByIdWithResponse(ctx, state.Id.ValueString(), reqEditors...)
+	if err != nil {
+		tflog.Error(ctx, "Error reading trigger category: ", map[string]interface{}{"error": err})
+		resp.Diagnostics.AddError("Error reading trigger category", err.Error())
+		return
+	}
+	if removeResourceWhenNotFound(ctx, response.StatusCode(), "zendesk_trigger_category", state.Id.ValueString(), resp) {
+		return
+	}
+	if response.StatusCode() != http.StatusOK || response.JSON200 == nil || response.JSON200.TriggerCategory == nil {
+		tflog.Error(ctx, "API error reading trigger category: "+response.Status(), map[string]interface{}{"error": string(response.Body)})
+		addAPIError(&resp.Diagnostics, "Error reading trigger category", response.HTTPResponse, response.Body, nil)
+		return
+	}
+
+	mapTriggerCategoryToModel(response.JSON200.TriggerCategory, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *triggerCategoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan triggerCategoryResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, err := json.Marshal(mapTriggerCategoryModelToRequest(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Error mapping trigger category to the API request", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Update trigger category", map[string]interface{}{"id": plan.Id.ValueString()})
+	reqEditors := make([]zendesk_api.RequestEditorFn, 0)
+	response, err := r.client.GetClient().UpdateTriggerCategoryWithBodyWithResponse(ctx, plan.Id.ValueString(), "application/json", bytes.NewReader(body), reqEditors...)
+	if err != nil {
+		tflog.Error(ctx, "Error updating trigger category: ", map[string]interface{}{"error": err})
+		resp.Diagnostics.AddError("Error updating trigger category", err.Error())
+		return
+	}
+	if response.StatusCode() != http.StatusOK || response.JSON200 == nil || response.JSON200.TriggerCategory == nil {
+		tflog.Error(ctx, "API error updating trigger category: "+response.Status(), map[string]interface{}{"error": string(response.Body)})
+		addAPIError(&resp.Diagnostics, "Error updating trigger category", response.HTTPResponse, response.Body, triggerCategoryAttributePaths)
+		return
+	}
+
+	mapTriggerCategoryToModel(response.JSON200.TriggerCategory, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *triggerCategoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state triggerCategoryResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Delete trigger category", map[string]interface{}{"id": state.Id.ValueString()})
+	reqEditors := make([]zendesk_api.RequestEditorFn, 0)
+	response, err := r.client.GetClient().DeleteTriggerCategoryWithResponse(ctx, state.Id.ValueString(), reqEditors...)
+	if err != nil {
+		tflog.Error(ctx, "Error deleting trigger category: ", map[string]interface{}{"error": err})
+		resp.Diagnostics.AddError("Error deleting trigger category", err.Error())
+		return
+	}
+	if response.StatusCode() != http.StatusNoContent && response.StatusCode() != http.StatusNotFound {
+		tflog.Error(ctx, "API error deleting trigger category: "+response.Status(), map[string]interface{}{"error": string(response.Body)})
+		// Zendesk refuses to delete a category which still has triggers
+		addAPIError(&resp.Diagnostics, "Error deleting trigger category", response.HTTPResponse, response.Body, nil)
+	}
+}
+
+// ImportState imports a trigger category by its id.
+func (r *triggerCategoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func mapTriggerCategoryModelToRequest(model triggerCategoryResourceModel) triggerCategoryRequest {
+	request := triggerCategoryRequest{TriggerCategory: zendesk_api.TriggerCategoryRequest{Name: model.Name.ValueStringPointer()}}
+	if !model.Position.IsNull() && !model.Position.IsUnknown() {
+		request.TriggerCategory.Position = model.Position.ValueInt64Pointer()
+	}
+	return request
+}
+
+func mapTriggerCategoryToModel(category *zendesk_api.TriggerCategory, model *triggerCategoryResourceModel) {
+	model.Id = types.StringPointerValue(category.Id)
+	model.Name = types.StringPointerValue(category.Name)
+	model.Position = types.Int64PointerValue(category.Position)
+	model.CreatedAt = types.StringPointerValue(category.CreatedAt)
+	model.UpdatedAt = types.StringPointerValue(category.UpdatedAt)
+}
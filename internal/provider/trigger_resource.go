@@ -0,0 +1,1043 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"net/http"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"terraform-provider-zendesk/zendesk_api"
+)
+
+var (
+	_ resource.Resource                   = (*triggerResource)(nil)
+	_ resource.ResourceWithConfigure      = (*triggerResource)(nil)
+	_ resource.ResourceWithImportState    = (*triggerResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*triggerResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*triggerResource)(nil)
+)
+
+func NewTriggerResource() resource.Resource {
+	return &triggerResource{}
+}
+
+type triggerResource struct {
+	client      *zendesk_api.SupportApi
+	definitions *triggerDefinitionsCache
+}
+
+type triggerResourceModel struct {
+	Id                types.String            `tfsdk:"id"`
+	Title             types.String            `tfsdk:"title"`
+	RawTitle          types.String            `tfsdk:"raw_title"`
+	Description       types.String            `tfsdk:"description"`
+	Active            types.Bool              `tfsdk:"active"`
+	CategoryId        types.String            `tfsdk:"category_id"`
+	Position          types.Int64             `tfsdk:"position"`
+	Default           types.Bool              `tfsdk:"default"`
+	CreatedAt         types.String            `tfsdk:"created_at"`
+	UpdatedAt         types.String            `tfsdk:"updated_at"`
+	RestoreRevisionId types.String            `tfsdk:"restore_revision_id"`
+	All               []triggerConditionModel `tfsdk:"all"`
+	Any               []triggerConditionModel `tfsdk:"any"`
+	Action            []triggerActionModel    `tfsdk:"action"`
+}
+
+type triggerConditionModel struct {
+	Field    types.String `tfsdk:"field"`
+	Operator types.String `tfsdk:"operator"`
+	Value    types.String `tfsdk:"value"`
+	Values   types.List   `tfsdk:"values"`
+}
+
+type triggerActionModel struct {
+	Field  types.String `tfsdk:"field"`
+	Value  types.String `tfsdk:"value"`
+	Values types.List   `tfsdk:"values"`
+}
+
+// triggerRequest is the body to create and update a trigger. The generated request type declares the trigger
+// as an anonymous struct, which cannot be built from a TriggerObject.
+type triggerRequest struct {
+	Trigger zendesk_api.TriggerObject `json:"trigger"`
+}
+
+// triggerAttributePaths maps the fields of validation errors of the trigger API to the Terraform paths.
+func triggerAttributePaths(field string) (path.Path, bool) {
+	switch strings.TrimPrefix(field, "trigger.") {
+	case "title", "raw_title", "description", "active", "category_id", "position":
+		return path.Root(strings.TrimPrefix(field, "trigger.")), true
+	}
+	return path.Empty(), false
+}
+
+// triggerDefinitionsCache reads the definitions of the trigger conditions and actions of the account once per
+// provider run, as every trigger in the plan is validated against them.
+type triggerDefinitionsCache struct {
+	mutex       sync.Mutex
+	definitions *zendesk_api.TriggerDefinitionObject
+}
+
+func (c *triggerDefinitionsCache) get(ctx context.Context, client *zendesk_api.SupportApi) (*zendesk_api.TriggerDefinitionObject, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.definitions != nil {
+		return c.definitions, nil
+	}
+
+	tflog.Debug(ctx, "Read trigger definitions")
+	reqEditors := make([]zendesk_api.RequestEditorFn, 0)
+	response, err := client.GetClient().ListTriggerActionConditionDefinitionsWithResponse(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode() != http.StatusOK || response.JSON200 == nil || response.JSON200.Definitions == nil {
+		return nil, fmt.Errorf("reading the trigger definitions failed with the status %v: %s",
+			response.StatusCode(), string(response.Body))
+	}
+	c.definitions = response.JSON200.Definitions
+	return c.definitions, nil
+}
+
+func (r *triggerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_trigger"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *triggerResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := request.ProviderData.(zendeskProviderData)
+
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *zendeskProviderData, got: %T. Please report this issue to the provider developers.",
+				request.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.supportApi
+	r.definitions = providerData.triggerDefinitions
+	if r.definitions == nil {
+		r.definitions = &triggerDefinitionsCache{}
+	}
+}
+
+func triggerConditionBlock(description string) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		Description: description,
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"field": schema.StringAttribute{
+					Description: "The condition, e.g. `status`, `custom_status_id` or `custom_fields_123`.",
+					Required:    true,
+				},
+				"operator": schema.StringAttribute{
+					Description: "The comparison operator, e.g. `is`, `less_than` or `includes`.",
+					Required:    true,
+				},
+				"value": schema.StringAttribute{
+					Description: "The value to compare with. Numbers are written as strings.",
+					Optional:    true,
+				},
+				"values": schema.ListAttribute{
+					Description: "The values to compare with, for conditions taking a list like `custom_status_id` with `includes`. " +
+						"Conflicts with `value`.",
+					ElementType: types.StringType,
+					Optional:    true,
+					Validators: []validator.List{
+						listvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("value")),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *triggerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a trigger. The conditions and actions are validated against the trigger definitions of the " +
+			"account while planning. See the [conditions](https://developer.zendesk.com/documentation/ticketing/reference-guides/conditions-reference/) " +
+			"and [actions](https://developer.zendesk.com/documentation/ticketing/reference-guides/actions-reference/) references.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Id of the trigger.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"title": schema.StringAttribute{
+				Description: "The title of the trigger. Conflicts with `raw_title`, the title is then rendered from it.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("raw_title")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"raw_title": schema.StringAttribute{
+				Description: "The title with dynamic content placeholders like `{{dc.escalation}}`. Conflicts with `title`, " +
+					"the raw title is the title when only that is set.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the trigger.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"active": schema.BoolAttribute{
+				Description: "Whether the trigger is active. Defaults to `true`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"category_id": schema.StringAttribute{
+				Description: "Id of the trigger category. Zendesk puts the trigger into the default category when not set. Leave it unset for triggers placed by a `zendesk_trigger_category_order`.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"position": schema.Int64Attribute{
+				Description: "Position of the trigger, determines the order they will execute in.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"default": schema.BoolAttribute{
+				Description: "Whether the trigger is a default trigger of the account.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The time the trigger was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "The time of the last update of the trigger.",
+				Computed:    true,
+			},
+			"restore_revision_id": schema.StringAttribute{
+				Description: "Id of a revision to roll the trigger back to, see the `zendesk_trigger_revisions` data source. " +
+					"The title, description, active flag, conditions and actions are then taken from the revision and must not " +
+					"be configured. The trigger is restored again when it was changed since. Remove the attribute and configure " +
+					"the trigger again to manage it from the configuration.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("title"),
+						path.MatchRoot("raw_title"),
+						path.MatchRoot("description"),
+						path.MatchRoot("active"),
+						path.MatchRoot("all"),
+						path.MatchRoot("any"),
+						path.MatchRoot("action"),
+					),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"all": triggerConditionBlock("Logical AND. All the conditions must be met."),
+			"any": triggerConditionBlock("Logical OR. Any condition can be met."),
+			"action": schema.ListNestedBlock{
+				Description: "What the trigger will do.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"field": schema.StringAttribute{
+							Description: "The action, e.g. `status`, `group_id` or `notification_webhook`.",
+							Required:    true,
+						},
+						"value": schema.StringAttribute{
+							Description: "The value to set. Numbers are written as strings.",
+							Optional:    true,
+						},
+						"values": schema.ListAttribute{
+							Description: "The values of actions taking a list, e.g. the webhook id and the body of " +
+								"`notification_webhook`. Conflicts with `value`.",
+							ElementType: types.StringType,
+							Optional:    true,
+							Validators: []validator.List{
+								listvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("value")),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *triggerResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config triggerResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.RestoreRevisionId.IsNull() {
+		if _, err := strconv.Atoi(config.RestoreRevisionId.ValueString()); !config.RestoreRevisionId.IsUnknown() && err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("restore_revision_id"), "Invalid Trigger Revision Id",
+				fmt.Sprintf("A trigger revision id is numeric, got %q", config.RestoreRevisionId.ValueString()))
+		}
+		// everything else comes from the revision
+		return
+	}
+
+	if config.Title.IsNull() && config.RawTitle.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("title"), "Missing Trigger Title",
+			"A trigger needs a title or a raw_title.")
+	}
+	if len(config.All) == 0 && len(config.Any) == 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("all"), "Missing Trigger Conditions",
+			"A trigger needs at least one all or any condition.")
+	}
+	if config.Action == nil {
+		resp.Diagnostics.AddAttributeError(path.Root("action"), "Missing Trigger Actions",
+			"A trigger needs at least one action.")
+	}
+}
+
+// ModifyPlan validates the conditions and actions against the trigger definitions of the account. The provider
+// is not configured yet when only validating, so this cannot happen in ValidateConfig.
+func (r *triggerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config triggerResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.RestoreRevisionId.IsNull() {
+		r.planRestore(ctx, req, resp)
+		return
+	}
+	resp.Diagnostics.Append(planDerivedAttributes(ctx, req, resp, config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if r.client == nil {
+		return
+	}
+
+	definitions, err := r.definitions.get(ctx, r.client)
+	if err != nil {
+		tflog.Warn(ctx, "Error reading trigger definitions, the conditions and actions are not validated", map[string]interface{}{"error": err})
+		resp.Diagnostics.AddWarning("Trigger conditions and actions not validated",
+			"The trigger definitions could not be read, so the conditions and actions are only validated by Zendesk when applying: "+err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(validateTriggerRules(ctx, definitions, config)...)
+}
+
+// planDerivedAttributes plans the attributes Zendesk derives from changed ones as unknown: the title that is not
+// configured when the configured one changes, Zendesk renders one from the other, and the position when the trigger
+// moves to another category. Otherwise they keep their state.
+func planDerivedAttributes(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, config triggerResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if req.State.Raw.IsNull() {
+		return diags
+	}
+
+	var plan, state triggerResourceModel
+	diags.Append(req.Plan.Get(ctx, &plan)...)
+	diags.Append(req.State.Get(ctx, &state)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	changed := false
+	if config.Title.IsNull() && !plan.RawTitle.Equal(state.RawTitle) {
+		plan.Title = types.StringUnknown()
+		changed = true
+	} else if config.RawTitle.IsNull() && !plan.Title.Equal(state.Title) {
+		plan.RawTitle = types.StringUnknown()
+		changed = true
+	}
+	if !plan.CategoryId.Equal(state.CategoryId) {
+		plan.Position = types.Int64Unknown()
+		changed = true
+	}
+	if !changed {
+		return diags
+	}
+	diags.Append(resp.Plan.Set(ctx, &plan)...)
+	return diags
+}
+
+// planRestore plans the attributes taken from the revision to restore: they keep their state while the revision
+// stays restored, and are unknown until a revision is restored.
+func (r *triggerResource) planRestore(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("restore_revision_id"), "Trigger Revision Restored On Create",
+			"restore_revision_id rolls back an existing trigger. Create the trigger with its conditions and actions first.")
+		return
+	}
+
+	var plan, state triggerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.RestoreRevisionId.Equal(state.RestoreRevisionId) {
+		plan.Title = state.Title
+		plan.RawTitle = state.RawTitle
+		plan.Description = state.Description
+		plan.Active = state.Active
+	} else {
+		tflog.Debug(ctx, "Plan to restore trigger revision", map[string]interface{}{"revision_id": plan.RestoreRevisionId.ValueString()})
+		plan.Title = types.StringUnknown()
+		plan.RawTitle = types.StringUnknown()
+		plan.Description = types.StringUnknown()
+		plan.Active = types.BoolUnknown()
+		plan.UpdatedAt = types.StringUnknown()
+	}
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// ruleDefinition is a condition or action of the trigger definitions with the operators and values it accepts.
+// An empty list accepts everything.
+type ruleDefinition struct {
+	valueType string
+	operators []string
+	values    []string
+}
+
+func conditionDefinitions[T zendesk_api.TriggerConditionDefinitionObjectAll | zendesk_api.TriggerConditionDefinitionObjectAny](definitionObjects *[]T) map[string]ruleDefinition {
+	definitions := make(map[string]ruleDefinition)
+	if definitionObjects == nil {
+		return definitions
+	}
+	for _, definitionObject := range *definitionObjects {
+		// both definition types have the same fields, the any conditions just lack the values
+		var object zendesk_api.TriggerConditionDefinitionObjectAll
+		switch typed := any(definitionObject).(type) {
+		case zendesk_api.TriggerConditionDefinitionObjectAll:
+			object = typed
+		case zendesk_api.TriggerConditionDefinitionObjectAny:
+			object = zendesk_api.TriggerConditionDefinitionObjectAll{Subject: typed.Subject, Type: typed.Type, Operators: typed.Operators}
+		}
+		if object.Subject == nil {
+			continue
+		}
+		definition := ruleDefinition{valueType: stringValue(object.Type)}
+		if object.Operators != nil {
+			for _, operator := range *object.Operators {
+				definition.operators = append(definition.operators, stringValue(operator.Value))
+			}
+		}
+		if object.Values != nil {
+			for _, value := range *object.Values {
+				definition.values = append(definition.values, stringValue(value.Value))
+			}
+		}
+		definitions[*object.Subject] = definition
+	}
+	return definitions
+}
+
+func actionDefinitions(definitionObjects *[]zendesk_api.TriggerActionDefinitionObject) map[string]ruleDefinition {
+	definitions := make(map[string]ruleDefinition)
+	if definitionObjects == nil {
+		return definitions
+	}
+	for _, object := range *definitionObjects {
+		if object.Subject == nil {
+			continue
+		}
+		definition := ruleDefinition{valueType: stringValue(object.Type)}
+		if object.Values != nil {
+			for _, value := range *object.Values {
+				definition.values = append(definition.values, stringValue(value.Value))
+			}
+		}
+		definitions[*object.Subject] = definition
+	}
+	return definitions
+}
+
+// validateTriggerRules checks that the fields, operators and values of the conditions and actions are known to
+// the trigger definitions. Values are only checked for definitions of the type list, whose values are fixed.
+func validateTriggerRules(ctx context.Context, definitions *zendesk_api.TriggerDefinitionObject, config triggerResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	validateConditions := func(block string, conditions []triggerConditionModel, known map[string]ruleDefinition) {
+		for i, condition := range conditions {
+			conditionPath := path.Root(block).AtListIndex(i)
+			if condition.Field.IsUnknown() {
+				continue
+			}
+			definition, ok := known[condition.Field.ValueString()]
+			if !ok {
+				diags.AddAttributeError(conditionPath.AtName("field"), "Unknown Trigger Condition",
+					fmt.Sprintf("%q is not a trigger condition of %s in this account, use one of: %s",
+						condition.Field.ValueString(), block, strings.Join(sortedKeys(known), ", ")))
+				continue
+			}
+			if !condition.Operator.IsUnknown() && len(definition.operators) > 0 && !slices.Contains(definition.operators, condition.Operator.ValueString()) {
+				diags.AddAttributeError(conditionPath.AtName("operator"), "Invalid Trigger Condition Operator",
+					fmt.Sprintf("The condition %q does not take the operator %q, use one of: %s",
+						condition.Field.ValueString(), condition.Operator.ValueString(), strings.Join(definition.operators, ", ")))
+			}
+			validateRuleValue(&diags, conditionPath, condition.Field.ValueString(), condition.Value, definition)
+		}
+	}
+	validateConditions("all", config.All, conditionDefinitions(definitions.ConditionsAll))
+	validateConditions("any", config.Any, conditionDefinitions(definitions.ConditionsAny))
+
+	known := actionDefinitions(definitions.Actions)
+	for i, action := range config.Action {
+		actionPath := path.Root("action").AtListIndex(i)
+		if action.Field.IsUnknown() {
+			continue
+		}
+		definition, ok := known[action.Field.ValueString()]
+		if !ok {
+			diags.AddAttributeError(actionPath.AtName("field"), "Unknown Trigger Action",
+				fmt.Sprintf("%q is not a trigger action in this account, use one of: %s",
+					action.Field.ValueString(), strings.Join(sortedKeys(known), ", ")))
+			continue
+		}
+		validateRuleValue(&diags, actionPath, action.Field.ValueString(), action.Value, definition)
+	}
+
+	if diags.HasError() {
+		tflog.Debug(ctx, "Trigger conditions or actions are not valid", map[string]interface{}{"errors": diags.ErrorsCount()})
+	}
+	return diags
+}
+
+func validateRuleValue(diags *diag.Diagnostics, rulePath path.Path, field string, value types.String, definition ruleDefinition) {
+	if definition.valueType != "list" || len(definition.values) == 0 || value.IsNull() || value.IsUnknown() {
+		return
+	}
+	if !slices.Contains(definition.values, value.ValueString()) {
+		diags.AddAttributeError(rulePath.AtName("value"), "Invalid Trigger Value",
+			fmt.Sprintf("%q does not take the value %q, use one of: %s",
+				field, value.ValueString(), strings.Join(definition.values, ", ")))
+	}
+}
+
+func (r *triggerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan triggerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := mapTriggerModelToRequestBody(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Create trigger", map[string]interface{}{"title": plan.Title.ValueString()})
+	reqEditors := make([]zendesk_api.RequestEditorFn, 0)
+	response, err := r.client.GetClient().CreateTriggerWithBodyWithResponse(ctx, "application/json", bytes.NewReader(body), reqEditors...)
+	if err != nil {
+		tflog.Error(ctx, "Error creating trigger: ", map[string]interface{}{"error": err})
+		resp.Diagnostics.AddError("Error creating trigger", err.Error())
+		return
+	}
+	if response.StatusCode() != http.StatusCreated || response.JSON201 == nil || response.JSON201.Trigger == nil {
+		tflog.Error(ctx, "API error creating trigger: "+response.Status(), map[string]interface{}{"error": string(response.Body)})
+		addAPIError(&resp.Diagnostics, "Error creating trigger", response.HTTPResponse, response.Body, triggerAttributePaths)
+		return
+	}
+
+	resp.Diagnostics.Append(mapTriggerToModel(ctx, response.JSON201.Trigger, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *triggerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state triggerResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	triggerId, err := strconv.Atoi(state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("id"), "Invalid Trigger Id", err.Error())
+		return
+	}
+
+	reqEditors := make([]zendesk_api.RequestEditorFn, 0)
+	response, err := r.client.GetClient().GetTriggerWithResponse(ctx, triggerId, reqEditors...)
+	if err != nil {
+		tflog.Error(ctx, "Error reading trigger: ", map[string]interface{}{"error": err})
+		resp.Diagnostics.AddError("Error reading trigger", err.Error())
+		return
+	}
+	if removeResourceWhenNotFound(ctx, response.StatusCode(), "zendesk_trigger", state.Id.ValueString(), resp) {
+		return
+	}
+	if response.StatusCode() != http.StatusOK || response.JSON200 == nil || response.JSON200.Trigger == nil {
+		tflog.Error(ctx, "API error reading trigger: "+response.Status(), map[string]interface{}{"error": string(response.Body)})
+		addAPIError(&resp.Diagnostics, "Error reading trigger", response.HTTPResponse, response.Body, nil)
+		return
+	}
+
+	resp.Diagnostics.Append(mapTriggerToModel(ctx, response.JSON200.Trigger, &state)...)
+	if !state.RestoreRevisionId.IsNull() {
+		revision := r.readTriggerRevision(ctx, triggerId, state.RestoreRevisionId.ValueString(), &resp.Diagnostics)
+		if revision == nil {
+			return
+		}
+		changes, err := triggerSnapshotChanges(revision, liveTriggerSnapshot(response.JSON200.Trigger, revision))
+		if err != nil {
+			resp.Diagnostics.AddError("Error comparing trigger with the restored revision", err.Error())
+			return
+		}
+		forgetRestoredRules(&state)
+		if len(changes) > 0 {
+			// planned to be restored again
+			tflog.Info(ctx, "Trigger changed since the revision was restored", map[string]interface{}{
+				"id": state.Id.ValueString(), "revision_id": state.RestoreRevisionId.ValueString(), "changes": len(changes)})
+			state.RestoreRevisionId = types.StringNull()
+		}
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *triggerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state triggerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	triggerId, err := strconv.Atoi(state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("id"), "Invalid Trigger Id", err.Error())
+		return
+	}
+
+	var body []byte
+	var diags diag.Diagnostics
+	if plan.RestoreRevisionId.IsNull() {
+		body, diags = mapTriggerModelToRequestBody(ctx, plan)
+	} else {
+		revision := r.readTriggerRevision(ctx, triggerId, plan.RestoreRevisionId.ValueString(), &resp.Diagnostics)
+		if revision == nil {
+			return
+		}
+		tflog.Info(ctx, "Restore trigger revision", map[string]interface{}{"id": triggerId, "revision_id": plan.RestoreRevisionId.ValueString()})
+		body, diags = mapTriggerRevisionToRequestBody(revision, plan.CategoryId)
+	}
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Update trigger", map[string]interface{}{"id": triggerId})
+	reqEditors := make([]zendesk_api.RequestEditorFn, 0)
+	response, err := r.client.GetClient().UpdateTriggerWithBodyWithResponse(ctx, triggerId, "application/json", bytes.NewReader(body), reqEditors...)
+	if err != nil {
+		tflog.Error(ctx, "Error updating trigger: ", map[string]interface{}{"error": err})
+		resp.Diagnostics.AddError("Error updating trigger", err.Error())
+		return
+	}
+	if response.StatusCode() != http.StatusOK || response.JSON200 == nil || response.JSON200.Trigger == nil {
+		tflog.Error(ctx, "API error updating trigger: "+response.Status(), map[string]interface{}{"error": string(response.Body)})
+		addAPIError(&resp.Diagnostics, "Error updating trigger", response.HTTPResponse, response.Body, triggerAttributePaths)
+		return
+	}
+
+	resp.Diagnostics.Append(mapTriggerToModel(ctx, response.JSON200.Trigger, &plan)...)
+	forgetRestoredRules(&plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// readTriggerRevision reads the snapshot of the trigger in a revision, nil after adding the error to diags.
+func (r *triggerResource) readTriggerRevision(ctx context.Context, triggerId int, revisionId string, diags *diag.Diagnostics) *zendesk_api.TriggerSnapshotObject {
+	triggerRevisionId, err := strconv.Atoi(revisionId)
+	if err != nil {
+		diags.AddAttributeError(path.Root("restore_revision_id"), "Invalid Trigger Revision Id", err.Error())
+		return nil
+	}
+
+	reqEditors := make([]zendesk_api.RequestEditorFn, 0)
+	response, err := r.client.GetClient().TriggerRevisionWithResponse(ctx, triggerId, triggerRevisionId, reqEditors...)
+	if err != nil {
+		tflog.Error(ctx, "Error reading trigger revision: ", map[string]interface{}{"error": err})
+		diags.AddError("Error reading trigger revision", err.Error())
+		return nil
+	}
+	if response.StatusCode() != http.StatusOK || response.JSON200 == nil || response.JSON200.TriggerRevision == nil {
+		tflog.Error(ctx, "API error reading trigger revision: "+response.Status(), map[string]interface{}{"error": string(response.Body)})
+		addAPIError(diags, "Error reading trigger revision "+revisionId, response.HTTPResponse, response.Body, nil)
+		return nil
+	}
+	return revisionSnapshot(*response.JSON200.TriggerRevision)
+}
+
+func (r *triggerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state triggerResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	triggerId, err := strconv.Atoi(state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("id"), "Invalid Trigger Id", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Delete trigger", map[string]interface{}{"id": triggerId})
+	reqEditors := make([]zendesk_api.RequestEditorFn, 0)
+	response, err := r.client.GetClient().DeleteTriggerWithResponse(ctx, triggerId, reqEditors...)
+	if err != nil {
+		tflog.Error(ctx, "Error deleting trigger: ", map[string]interface{}{"error": err})
+		resp.Diagnostics.AddError("Error deleting trigger", err.Error())
+		return
+	}
+	if response.StatusCode() != http.StatusNoContent && response.StatusCode() != http.StatusNotFound {
+		tflog.Error(ctx, "API error deleting trigger: "+response.Status(), map[string]interface{}{"error": string(response.Body)})
+		addAPIError(&resp.Diagnostics, "Error deleting trigger", response.HTTPResponse, response.Body, nil)
+	}
+}
+
+// ImportState imports a trigger by its numeric id.
+func (r *triggerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if _, err := strconv.Atoi(req.ID); err != nil {
+		resp.Diagnostics.AddError("Invalid Trigger Id", fmt.Sprintf("A trigger is imported by its numeric id, got %q", req.ID))
+		return
+	}
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func mapTriggerModelToRequestBody(ctx context.Context, model triggerResourceModel) ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	trigger := zendesk_api.TriggerObject{
+		Title:       model.Title.ValueString(),
+		Description: model.Description.ValueStringPointer(),
+		Active:      model.Active.ValueBoolPointer(),
+		Actions:     make([]zendesk_api.TriggerActionObject, 0, len(model.Action)),
+	}
+	// without a raw title Zendesk would keep rendering the title from the former one
+	trigger.RawTitle = &trigger.Title
+	if !model.RawTitle.IsNull() && !model.RawTitle.IsUnknown() {
+		trigger.Title = model.RawTitle.ValueString()
+		trigger.RawTitle = model.RawTitle.ValueStringPointer()
+	}
+	if !model.CategoryId.IsNull() && !model.CategoryId.IsUnknown() {
+		trigger.CategoryId = model.CategoryId.ValueStringPointer()
+	}
+
+	mapConditions := func(conditions []triggerConditionModel) *[]zendesk_api.TriggerConditionObject {
+		objects := make([]zendesk_api.TriggerConditionObject, 0, len(conditions))
+		for _, condition := range conditions {
+			object := zendesk_api.TriggerConditionObject{
+				Field:    condition.Field.ValueStringPointer(),
+				Operator: condition.Operator.ValueStringPointer(),
+			}
+			raw, valueDiags := ruleValueToJSON(ctx, condition.Value, condition.Values)
+			diags.Append(valueDiags...)
+			if raw != nil {
+				object.Value = &zendesk_api.TriggerConditionObject_Value{}
+				if err := object.Value.UnmarshalJSON(raw); err != nil {
+					diags.AddError("Error mapping trigger condition", err.Error())
+				}
+			}
+			objects = append(objects, object)
+		}
+		return &objects
+	}
+	trigger.Conditions.All = mapConditions(model.All)
+	trigger.Conditions.Any = mapConditions(model.Any)
+
+	for _, action := range model.Action {
+		object := zendesk_api.TriggerActionObject{Field: action.Field.ValueStringPointer()}
+		raw, valueDiags := ruleValueToJSON(ctx, action.Value, action.Values)
+		diags.Append(valueDiags...)
+		if raw != nil {
+			object.Value = &zendesk_api.TriggerActionObject_Value{}
+			if err := object.Value.UnmarshalJSON(raw); err != nil {
+				diags.AddError("Error mapping trigger action", err.Error())
+			}
+		}
+		trigger.Actions = append(trigger.Actions, object)
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	body, err := json.Marshal(triggerRequest{Trigger: trigger})
+	if err != nil {
+		diags.AddError("Error mapping trigger to the API request", err.Error())
+	}
+	return body, diags
+}
+
+// mapTriggerRevisionToRequestBody maps the snapshot of a revision to the body restoring it. The category is not
+// part of a revision and stays as planned.
+func mapTriggerRevisionToRequestBody(revision *zendesk_api.TriggerSnapshotObject, categoryId types.String) ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	trigger := zendesk_api.TriggerObject{
+		Title:       stringValue(revision.Title),
+		Description: revision.Description,
+		Active:      revision.Active,
+		Actions:     make([]zendesk_api.TriggerActionObject, 0),
+	}
+	trigger.RawTitle = &trigger.Title
+	if !categoryId.IsNull() && !categoryId.IsUnknown() {
+		trigger.CategoryId = categoryId.ValueStringPointer()
+	}
+	if revision.Conditions != nil {
+		trigger.Conditions = *revision.Conditions
+	}
+	if trigger.Conditions.All == nil {
+		trigger.Conditions.All = &[]zendesk_api.TriggerConditionObject{}
+	}
+	if trigger.Conditions.Any == nil {
+		trigger.Conditions.Any = &[]zendesk_api.TriggerConditionObject{}
+	}
+	if revision.Actions != nil {
+		trigger.Actions = *revision.Actions
+	}
+
+	body, err := json.Marshal(triggerRequest{Trigger: trigger})
+	if err != nil {
+		diags.AddError("Error mapping trigger revision to the API request", err.Error())
+	}
+	return body, diags
+}
+
+// liveTriggerSnapshot is the trigger as a snapshot, to compare it with the snapshot of a revision.
+func liveTriggerSnapshot(trigger *zendesk_api.TriggerObject, revision *zendesk_api.TriggerSnapshotObject) *zendesk_api.TriggerSnapshotObject {
+	snapshot := &zendesk_api.TriggerSnapshotObject{
+		Title:       &trigger.Title,
+		Description: trigger.Description,
+		Active:      trigger.Active,
+		Conditions:  &trigger.Conditions,
+		Actions:     &trigger.Actions,
+	}
+	// the title of a revision may be the raw title with its dynamic content placeholders
+	if trigger.RawTitle != nil && revision.Title != nil && *revision.Title == *trigger.RawTitle {
+		snapshot.Title = trigger.RawTitle
+	}
+	return snapshot
+}
+
+// forgetRestoredRules drops the conditions and actions of a restored trigger from the model, as they are not
+// configured but taken from the revision.
+func forgetRestoredRules(model *triggerResourceModel) {
+	if model.RestoreRevisionId.IsNull() {
+		return
+	}
+	model.All = nil
+	model.Any = nil
+	model.Action = nil
+}
+
+func mapTriggerToModel(ctx context.Context, trigger *zendesk_api.TriggerObject, model *triggerResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if trigger.Id != nil {
+		model.Id = types.StringValue(strconv.Itoa(*trigger.Id))
+	}
+	model.Title = types.StringValue(trigger.Title)
+	model.RawTitle = types.StringValue(trigger.Title)
+	if trigger.RawTitle != nil {
+		model.RawTitle = types.StringValue(*trigger.RawTitle)
+	}
+	model.Description = types.StringValue(stringValue(trigger.Description))
+	model.Active = types.BoolPointerValue(trigger.Active)
+	model.CategoryId = types.StringPointerValue(trigger.CategoryId)
+	model.Position = int64PointerValue(trigger.Position)
+	model.Default = types.BoolPointerValue(trigger.Default)
+	model.CreatedAt = types.StringPointerValue(trigger.CreatedAt)
+	model.UpdatedAt = types.StringPointerValue(trigger.UpdatedAt)
+
+	mapConditions := func(objects *[]zendesk_api.TriggerConditionObject) []triggerConditionModel {
+		if objects == nil || len(*objects) == 0 {
+			return nil
+		}
+		conditions := make([]triggerConditionModel, 0, len(*objects))
+		for _, object := range *objects {
+			condition := triggerConditionModel{
+				Field:    types.StringPointerValue(object.Field),
+				Operator: types.StringPointerValue(object.Operator),
+				Value:    types.StringNull(),
+				Values:   types.ListNull(types.StringType),
+			}
+			if object.Value != nil {
+				raw, err := object.Value.MarshalJSON()
+				if err != nil {
+					diags.AddError("Error mapping trigger condition", err.Error())
+					continue
+				}
+				condition.Value, condition.Values, err = ruleValueFromJSON(raw)
+				if err != nil {
+					diags.AddError("Error mapping trigger condition", err.Error())
+				}
+			}
+			conditions = append(conditions, condition)
+		}
+		return conditions
+	}
+	model.All = mapConditions(trigger.Conditions.All)
+	model.Any = mapConditions(trigger.Conditions.Any)
+
+	model.Action = make([]triggerActionModel, 0, len(trigger.Actions))
+	for _, object := range trigger.Actions {
+		action := triggerActionModel{
+			Field:  types.StringPointerValue(object.Field),
+			Value:  types.StringNull(),
+			Values: types.ListNull(types.StringType),
+		}
+		if object.Value != nil {
+			raw, err := object.Value.MarshalJSON()
+			if err != nil {
+				diags.AddError("Error mapping trigger action", err.Error())
+				continue
+			}
+			action.Value, action.Values, err = ruleValueFromJSON(raw)
+			if err != nil {
+				diags.AddError("Error mapping trigger action", err.Error())
+			}
+		}
+		model.Action = append(model.Action, action)
+	}
+
+	tflog.Debug(ctx, "Mapped trigger", map[string]interface{}{"id": model.Id.ValueString()})
+	return diags
+}
+
+// ruleValueToJSON maps the value or values attribute of a condition or action to the JSON of its value, nil when
+// neither is set. Terraform sends every value as a string, which Zendesk also accepts for numeric values.
+func ruleValueToJSON(ctx context.Context, value types.String, values types.List) (json.RawMessage, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if !values.IsNull() && !values.IsUnknown() {
+		elements := make([]string, 0, len(values.Elements()))
+		diags.Append(values.ElementsAs(ctx, &elements, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		raw, err := json.Marshal(elements)
+		if err != nil {
+			diags.AddError("Error mapping trigger values", err.Error())
+		}
+		return raw, diags
+	}
+	if value.IsNull() || value.IsUnknown() {
+		return nil, diags
+	}
+	raw, err := json.Marshal(value.ValueString())
+	if err != nil {
+		diags.AddError("Error mapping trigger value", err.Error())
+	}
+	return raw, diags
+}
+
+// ruleValueFromJSON maps the JSON value of a condition or action to the value attribute, or to the values
+// attribute when it is a list. Numbers and booleans are kept as written in the JSON.
+func ruleValueFromJSON(raw json.RawMessage) (types.String, types.List, error) {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 || string(raw) == "null" {
+		return types.StringNull(), types.ListNull(types.StringType), nil
+	}
+	if raw[0] != '[' {
+		value, err := jsonScalarToString(raw)
+		if err != nil {
+			return types.StringNull(), types.ListNull(types.StringType), err
+		}
+		return types.StringValue(value), types.ListNull(types.StringType), nil
+	}
+
+	var items []json.RawMessage
+	if err := json.Unmarshal(raw, &items); err != nil {
+		return types.StringNull(), types.ListNull(types.StringType), err
+	}
+	values := make([]types.String, 0, len(items))
+	for _, item := range items {
+		value, err := jsonScalarToString(item)
+		if err != nil {
+			return types.StringNull(), types.ListNull(types.StringType), err
+		}
+		values = append(values, types.StringValue(value))
+	}
+	list, diags := types.ListValueFrom(context.Background(), types.StringType, values)
+	if diags.HasError() {
+		return types.StringNull(), types.ListNull(types.StringType), fmt.Errorf("mapping the values %s", raw)
+	}
+	return types.StringNull(), list, nil
+}
+
+func jsonScalarToString(raw json.RawMessage) (string, error) {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) > 0 && raw[0] == '"' {
+		var value string
+		err := json.Unmarshal(raw, &value)
+		return value, err
+	}
+	return string(raw), nil
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func sortedKeys(definitions map[string]ruleDefinition) []string {
+	keys := make([]string, 0, len(definitions))
+	for key := range definitions {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,261 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gotest.tools/v3/assert"
+	"terraform-provider-zendesk/zendesk_api"
+)
+
+const triggerDefinitionsJSON = `{
+  "actions": [
+    {"subject": "status", "type": "list", "values": [{"value": "open"}, {"value": "solved"}]},
+    {"subject": "notification_webhook", "type": "list_list"}
+  ],
+  "conditions_all": [
+    {"subject": "status", "type": "list", "operators": [{"value": "is"}, {"value": "is_not"}],
+     "values": [{"value": "new"}, {"value": "open"}]},
+    {"subject": "custom_status_id", "type": "list", "operators": [{"value": "includes"}]}
+  ],
+  "conditions_any": [
+    {"subject": "priority", "type": "list", "operators": [{"value": "is"}]}
+  ]
+}`
+
+func TestValidateTriggerRules(t *testing.T) {
+	var definitions zendesk_api.TriggerDefinitionObject
+	assert.NilError(t, json.Unmarshal([]byte(triggerDefinitionsJSON), &definitions))
+
+	condition := func(field, operator, value string) triggerConditionModel {
+		return triggerConditionModel{
+			Field:    types.StringValue(field),
+			Operator: types.StringValue(operator),
+			Value:    types.StringValue(value),
+			Values:   types.ListNull(types.StringType),
+		}
+	}
+	action := func(field, value string) triggerActionModel {
+		return triggerActionModel{Field: types.StringValue(field), Value: types.StringValue(value), Values: types.ListNull(types.StringType)}
+	}
+
+	tests := []struct {
+		name       string
+		config     triggerResourceModel
+		wantErrors []string
+	}{
+		{
+			name: "valid",
+			config: triggerResourceModel{
+				All:    []triggerConditionModel{condition("status", "is", "new")},
+				Any:    []triggerConditionModel{condition("priority", "is", "urgent")},
+				Action: []triggerActionModel{action("status", "open")},
+			},
+		},
+		{
+			name: "unknown values are not validated",
+			config: triggerResourceModel{
+				All:    []triggerConditionModel{{Field: types.StringValue("status"), Operator: types.StringValue("is"), Value: types.StringUnknown()}},
+				Action: []triggerActionModel{{Field: types.StringUnknown(), Value: types.StringValue("anything")}},
+			},
+		},
+		{
+			name: "unknown field",
+			config: triggerResourceModel{
+				Any:    []triggerConditionModel{condition("status", "is", "new")},
+				Action: []triggerActionModel{action("assignee", "me")},
+			},
+			wantErrors: []string{
+				`"status" is not a trigger condition of any in this account, use one of: priority`,
+				`"assignee" is not a trigger action in this account, use one of: notification_webhook, status`,
+			},
+		},
+		{
+			name: "invalid operator and value",
+			config: triggerResourceModel{
+				All:    []triggerConditionModel{condition("status", "less_than", "closed")},
+				Action: []triggerActionModel{action("status", "closed")},
+			},
+			wantErrors: []string{
+				`The condition "status" does not take the operator "less_than", use one of: is, is_not`,
+				`"status" does not take the value "closed", use one of: new, open`,
+				`"status" does not take the value "closed", use one of: open, solved`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := validateTriggerRules(context.Background(), &definitions, tt.config)
+			var details []string
+			for _, d := range diags.Errors() {
+				details = append(details, d.Detail())
+			}
+			assert.DeepEqual(t, details, tt.wantErrors)
+		})
+	}
+}
+
+func TestMapTriggerModelToRequestBody(t *testing.T) {
+	ctx := context.Background()
+	values, _ := types.ListValueFrom(ctx, types.StringType, []string{"01HWEBHOOK", `{"ticket": "{{ticket.id}}"}`})
+	statuses, _ := types.ListValueFrom(ctx, types.StringType, []string{"1", "2"})
+
+	body, diags := mapTriggerModelToRequestBody(ctx, triggerResourceModel{
+		Title:       types.StringNull(),
+		RawTitle:    types.StringValue("{{dc.escalation}}"),
+		Description: types.StringValue(""),
+		Active:      types.BoolValue(true),
+		CategoryId:  types.StringUnknown(),
+		All: []triggerConditionModel{
+			{Field: types.StringValue("custom_status_id"), Operator: types.StringValue("includes"), Value: types.StringNull(), Values: statuses},
+		},
+		Action: []triggerActionModel{
+			{Field: types.StringValue("notification_webhook"), Value: types.StringNull(), Values: values},
+			{Field: types.StringValue("group_id"), Value: types.StringValue("42"), Values: types.ListNull(types.StringType)},
+		},
+	})
+	assert.Assert(t, !diags.HasError(), diags)
+	assert.Equal(t, string(body), `{"trigger":{"actions":[`+
+		`{"field":"notification_webhook","value":["01HWEBHOOK","{\"ticket\": \"{{ticket.id}}\"}"]},`+
+		`{"field":"group_id","value":"42"}],`+
+		`"active":true,"conditions":{"all":[{"field":"custom_status_id","operator":"includes","value":["1","2"]}],"any":[]},`+
+		`"description":"","raw_title":"{{dc.escalation}}","title":"{{dc.escalation}}"}}`)
+}
+
+func TestMapTriggerToModel(t *testing.T) {
+	var response zendesk_api.TriggerResponse
+	assert.NilError(t, json.Unmarshal([]byte(`{"trigger": {
+		"id": 123, "title": "Escalation", "raw_title": "{{dc.escalation}}", "active": true, "position": 4,
+		"category_id": "10", "default": false, "description": null,
+		"conditions": {"all": [{"field": "custom_status_id", "operator": "includes", "value": [1, "2"]}], "any": []},
+		"actions": [{"field": "group_id", "value": 42}, {"field": "status", "value": "open"}, {"field": "remove_tags", "value": null}]
+	}}`), &response))
+
+	var model triggerResourceModel
+	diags := mapTriggerToModel(context.Background(), response.Trigger, &model)
+	assert.Assert(t, !diags.HasError(), diags)
+
+	assert.Equal(t, model.Id.ValueString(), "123")
+	assert.Equal(t, model.Title.ValueString(), "Escalation")
+	assert.Equal(t, model.RawTitle.ValueString(), "{{dc.escalation}}")
+	assert.Equal(t, model.Description.ValueString(), "")
+	assert.Equal(t, model.Position.ValueInt64(), int64(4))
+	assert.Equal(t, model.CategoryId.ValueString(), "10")
+	assert.Assert(t, model.Any == nil)
+
+	assert.Equal(t, len(model.All), 1)
+	assert.Assert(t, model.All[0].Value.IsNull())
+	assert.Equal(t, model.All[0].Values.String(), `["1","2"]`)
+
+	assert.Equal(t, len(model.Action), 3)
+	assert.Equal(t, model.Action[0].Value.ValueString(), "42")
+	assert.Equal(t, model.Action[1].Value.ValueString(), "open")
+	assert.Assert(t, model.Action[1].Values.IsNull())
+	assert.Assert(t, model.Action[2].Value.IsNull())
+}
+
+func TestRestoreTriggerRevision(t *testing.T) {
+	var response zendesk_api.TriggerRevisionResponse
+	assert.NilError(t, json.Unmarshal([]byte(`{"trigger_revision": {"id": 100, "snapshot": {
+		"title": "{{dc.escalation}}", "description": "Escalate", "active": false,
+		"conditions": {"any": [{"field": "priority", "operator": "is", "value": "urgent"}]},
+		"actions": [{"field": "group_id", "value": 42}]
+	}}}`), &response))
+	revision := revisionSnapshot(*response.TriggerRevision)
+
+	body, diags := mapTriggerRevisionToRequestBody(revision, types.StringValue("10"))
+	assert.Assert(t, !diags.HasError(), diags)
+	assert.Equal(t, string(body), `{"trigger":{"actions":[{"field":"group_id","value":42}],"active":false,"category_id":"10",`+
+		`"conditions":{"all":[],"any":[{"field":"priority","operator":"is","value":"urgent"}]},`+
+		`"description":"Escalate","raw_title":"{{dc.escalation}}","title":"{{dc.escalation}}"}}`)
+
+	var trigger zendesk_api.TriggerResponse
+	assert.NilError(t, json.Unmarshal([]byte(`{"trigger": {
+		"id": 123, "title": "Escalation", "raw_title": "{{dc.escalation}}", "description": "Escalate", "active": false,
+		"conditions": {"all": [], "any": [{"field": "priority", "operator": "is", "value": "urgent"}]},
+		"actions": [{"field": "group_id", "value": "42"}]
+	}}`), &trigger))
+
+	changes, err := triggerSnapshotChanges(revision, liveTriggerSnapshot(trigger.Trigger, revision))
+	assert.NilError(t, err)
+	assert.Equal(t, len(changes), 0)
+
+	active := true
+	trigger.Trigger.Active = &active
+	changes, err = triggerSnapshotChanges(revision, liveTriggerSnapshot(trigger.Trigger, revision))
+	assert.NilError(t, err)
+	assert.Equal(t, len(changes), 2)
+}
+
+func TestPlanDerivedAttributes(t *testing.T) {
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	(&triggerResource{}).Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	current := triggerResourceModel{
+		Id:                types.StringValue("123"),
+		Title:             types.StringValue("Escalation"),
+		RawTitle:          types.StringValue("{{dc.escalation}}"),
+		Description:       types.StringValue(""),
+		Active:            types.BoolValue(true),
+		CategoryId:        types.StringValue("10"),
+		Position:          types.Int64Value(1),
+		Default:           types.BoolValue(false),
+		CreatedAt:         types.StringValue("2024-01-01T00:00:00Z"),
+		UpdatedAt:         types.StringValue("2024-01-01T00:00:00Z"),
+		RestoreRevisionId: types.StringNull(),
+	}
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	assert.Assert(t, !state.Set(ctx, &current).HasError())
+
+	// planOf plans the config like UseStateForUnknown does before the derived attributes are planned.
+	planOf := func(config triggerResourceModel) triggerResourceModel {
+		proposed := config
+		if proposed.Title.IsNull() {
+			proposed.Title = current.Title
+		}
+		if proposed.RawTitle.IsNull() {
+			proposed.RawTitle = current.RawTitle
+		}
+		plan := tfsdk.Plan{Schema: schemaResp.Schema}
+		assert.Assert(t, !plan.Set(ctx, &proposed).HasError())
+		resp := &resource.ModifyPlanResponse{Plan: plan}
+		diags := planDerivedAttributes(ctx, resource.ModifyPlanRequest{State: state, Plan: plan}, resp, config)
+		assert.Assert(t, !diags.HasError(), diags)
+		var planned triggerResourceModel
+		assert.Assert(t, !resp.Plan.Get(ctx, &planned).HasError())
+		return planned
+	}
+
+	unchanged := current
+	unchanged.Title = types.StringNull()
+	planned := planOf(unchanged)
+	assert.Equal(t, planned.Title.ValueString(), "Escalation")
+	assert.Equal(t, planned.RawTitle.ValueString(), "{{dc.escalation}}")
+	assert.Equal(t, planned.Position.ValueInt64(), int64(1))
+
+	rawTitleChanged := current
+	rawTitleChanged.Title = types.StringNull()
+	rawTitleChanged.RawTitle = types.StringValue("{{dc.urgent_escalation}}")
+	planned = planOf(rawTitleChanged)
+	assert.Assert(t, planned.Title.IsUnknown())
+	assert.Equal(t, planned.RawTitle.ValueString(), "{{dc.urgent_escalation}}")
+
+	titleChanged := current
+	titleChanged.Title = types.StringValue("Urgent escalation")
+	titleChanged.RawTitle = types.StringNull()
+	planned = planOf(titleChanged)
+	assert.Assert(t, planned.RawTitle.IsUnknown())
+	assert.Equal(t, planned.Title.ValueString(), "Urgent escalation")
+
+	moved := current
+	moved.Title = types.StringNull()
+	moved.CategoryId = types.StringValue("20")
+	planned = planOf(moved)
+	assert.Assert(t, planned.Position.IsUnknown())
+	assert.Equal(t, planned.Title.ValueString(), "Escalation")
+}
@@ -0,0 +1,413 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strconv"
+	"terraform-provider-zendesk/zendesk_api"
+)
+
+var (
+	_ datasource.DataSource              = (*triggerRevisionsDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*triggerRevisionsDataSource)(nil)
+)
+
+func NewTriggerRevisionsDataSource() datasource.DataSource {
+	return &triggerRevisionsDataSource{}
+}
+
+type triggerRevisionsDataSource struct {
+	client *zendesk_api.SupportApi
+}
+
+type triggerRevisionsDataSourceModel struct {
+	TriggerId types.String           `tfsdk:"trigger_id"`
+	Revisions []triggerRevisionModel `tfsdk:"revisions"`
+}
+
+type triggerRevisionModel struct {
+	Id          types.String                 `tfsdk:"id"`
+	AuthorId    types.String                 `tfsdk:"author_id"`
+	CreatedAt   types.String                 `tfsdk:"created_at"`
+	Title       types.String                 `tfsdk:"title"`
+	Description types.String                 `tfsdk:"description"`
+	Active      types.Bool                   `tfsdk:"active"`
+	Changes     []triggerRevisionChangeModel `tfsdk:"changes"`
+}
+
+type triggerRevisionChangeModel struct {
+	Attribute types.String `tfsdk:"attribute"`
+	Change    types.String `tfsdk:"change"`
+	Value     types.String `tfsdk:"value"`
+}
+
+const (
+	triggerRevisionAdded   = "added"
+	triggerRevisionRemoved = "removed"
+)
+
+func (d *triggerRevisionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_trigger_revisions"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *triggerRevisionsDataSource) Configure(ctx context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := request.ProviderData.(zendeskProviderData)
+
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *zendeskProviderData, got: %T. Please report this issue to the provider developers.",
+				request.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.supportApi
+}
+
+func (d *triggerRevisionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the revision history of a trigger: who changed it when, and what changed compared to the " +
+			"revision before. Zendesk keeps trigger revisions on Enterprise plans only.",
+		Attributes: map[string]schema.Attribute{
+			"trigger_id": schema.StringAttribute{
+				Description: "Id of the trigger.",
+				Required:    true,
+			},
+			"revisions": schema.ListNestedAttribute{
+				Description: "Revisions of the trigger, the newest first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Id of the revision, to roll the trigger back with its `restore_revision_id`.",
+							Computed:    true,
+						},
+						"author_id": schema.StringAttribute{
+							Description: "Id of the user who made the revision.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "When the revision was made.",
+							Computed:    true,
+						},
+						"title": schema.StringAttribute{
+							Description: "The title of the trigger in this revision.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The description of the trigger in this revision.",
+							Computed:    true,
+						},
+						"active": schema.BoolAttribute{
+							Description: "Whether the trigger was active in this revision.",
+							Computed:    true,
+						},
+						"changes": schema.ListNestedAttribute{
+							Description: "What changed compared to the revision before. Everything is added in the first revision.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"attribute": schema.StringAttribute{
+										Description: "The changed attribute: `title`, `description`, `active`, `all`, `any` or `action`.",
+										Computed:    true,
+									},
+									"change": schema.StringAttribute{
+										Description: "`added` or `removed`. A changed title is removed with its former value and added with the new one.",
+										Computed:    true,
+									},
+									"value": schema.StringAttribute{
+										Description: "The value. Conditions and actions are JSON objects with their `field`, `operator` " +
+											"and `value`, to be read with `jsondecode`.",
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *triggerRevisionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config triggerRevisionsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	triggerId, err := strconv.Atoi(config.TriggerId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("trigger_id"), "Invalid Trigger Id", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Read trigger revisions", map[string]interface{}{"trigger_id": triggerId})
+	var revisions []zendesk_api.TriggerRevisionObject
+	err = d.client.ForEachTriggerRevision(ctx, triggerId, func(revision zendesk_api.TriggerRevisionObject) bool {
+		revisions = append(revisions, revision)
+		return true
+	})
+	if err != nil {
+		tflog.Error(ctx, "Error reading trigger revisions from the API: ", map[string]interface{}{"error": err})
+		resp.Diagnostics.AddError("Error reading trigger revisions from the API", err.Error())
+		return
+	}
+
+	config.Revisions, err = mapTriggerRevisionsToModel(revisions)
+	if err != nil {
+		resp.Diagnostics.AddError("Error mapping trigger revisions", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// mapTriggerRevisionsToModel maps the revisions, the newest first, with the changes of each of them compared to the
+// next older one.
+func mapTriggerRevisionsToModel(revisions []zendesk_api.TriggerRevisionObject) ([]triggerRevisionModel, error) {
+	models := make([]triggerRevisionModel, 0, len(revisions))
+	for i, revision := range revisions {
+		var before *zendesk_api.TriggerSnapshotObject
+		if i+1 < len(revisions) {
+			before = revisionSnapshot(revisions[i+1])
+		}
+		snapshot := revisionSnapshot(revision)
+		changes, err := triggerSnapshotChanges(before, snapshot)
+		if err != nil {
+			return nil, fmt.Errorf("revision %d: %w", intValue(revision.Id), err)
+		}
+
+		model := triggerRevisionModel{
+			Id:          types.StringNull(),
+			AuthorId:    types.StringNull(),
+			CreatedAt:   types.StringPointerValue(revision.CreatedAt),
+			Title:       types.StringPointerValue(snapshot.Title),
+			Description: types.StringValue(stringValue(snapshot.Description)),
+			Active:      types.BoolPointerValue(snapshot.Active),
+			Changes:     changes,
+		}
+		if revision.Id != nil {
+			model.Id = types.StringValue(strconv.Itoa(*revision.Id))
+		}
+		if revision.AuthorId != nil {
+			model.AuthorId = types.StringValue(strconv.Itoa(*revision.AuthorId))
+		}
+		models = append(models, model)
+	}
+	return models, nil
+}
+
+func revisionSnapshot(revision zendesk_api.TriggerRevisionObject) *zendesk_api.TriggerSnapshotObject {
+	if revision.Snapshot == nil {
+		return &zendesk_api.TriggerSnapshotObject{}
+	}
+	return revision.Snapshot
+}
+
+// triggerSnapshotChanges lists what was removed from and added to the trigger between two snapshots. Conditions
+// and actions are compared as a whole, a condition with another value is removed and added. Everything of after
+// is added when there is no snapshot before.
+func triggerSnapshotChanges(before, after *zendesk_api.TriggerSnapshotObject) ([]triggerRevisionChangeModel, error) {
+	changes := make([]triggerRevisionChangeModel, 0)
+	if before == nil {
+		before = &zendesk_api.TriggerSnapshotObject{}
+	}
+
+	change := func(attribute, kind, value string) {
+		changes = append(changes, triggerRevisionChangeModel{
+			Attribute: types.StringValue(attribute),
+			Change:    types.StringValue(kind),
+			Value:     types.StringValue(value),
+		})
+	}
+	changeValue := func(attribute string, beforeValue, afterValue *string) {
+		if beforeValue != nil && afterValue != nil && *beforeValue == *afterValue {
+			return
+		}
+		if beforeValue != nil {
+			change(attribute, triggerRevisionRemoved, *beforeValue)
+		}
+		if afterValue != nil {
+			change(attribute, triggerRevisionAdded, *afterValue)
+		}
+	}
+	changeRules := func(attribute string, beforeRules, afterRules []string) {
+		remaining := make(map[string]int)
+		for _, rule := range afterRules {
+			remaining[rule]++
+		}
+		for _, rule := range beforeRules {
+			if remaining[rule] > 0 {
+				remaining[rule]--
+				continue
+			}
+			change(attribute, triggerRevisionRemoved, rule)
+		}
+		remaining = make(map[string]int)
+		for _, rule := range beforeRules {
+			remaining[rule]++
+		}
+		for _, rule := range afterRules {
+			if remaining[rule] > 0 {
+				remaining[rule]--
+				continue
+			}
+			change(attribute, triggerRevisionAdded, rule)
+		}
+	}
+
+	changeValue("title", before.Title, after.Title)
+	changeValue("description", nonEmptyString(before.Description), nonEmptyString(after.Description))
+	changeValue("active", boolString(before.Active), boolString(after.Active))
+
+	for _, block := range []string{"all", "any"} {
+		beforeRules, err := snapshotConditions(before, block)
+		if err != nil {
+			return nil, err
+		}
+		afterRules, err := snapshotConditions(after, block)
+		if err != nil {
+			return nil, err
+		}
+		changeRules(block, beforeRules, afterRules)
+	}
+
+	beforeActions, err := snapshotActions(before)
+	if err != nil {
+		return nil, err
+	}
+	afterActions, err := snapshotActions(after)
+	if err != nil {
+		return nil, err
+	}
+	changeRules("action", beforeActions, afterActions)
+
+	return changes, nil
+}
+
+// triggerRule is the JSON of a condition or action in the changes, with its value as the strings of the Terraform
+// attributes, so that 1 and "1" compare equal.
+type triggerRule struct {
+	Field    string `json:"field"`
+	Operator string `json:"operator,omitempty"`
+	Value    any    `json:"value"`
+}
+
+func snapshotConditions(snapshot *zendesk_api.TriggerSnapshotObject, block string) ([]string, error) {
+	if snapshot.Conditions == nil {
+		return nil, nil
+	}
+	conditions := snapshot.Conditions.All
+	if block == "any" {
+		conditions = snapshot.Conditions.Any
+	}
+	if conditions == nil {
+		return nil, nil
+	}
+	rules := make([]string, 0, len(*conditions))
+	for _, condition := range *conditions {
+		var raw json.RawMessage
+		if condition.Value != nil {
+			var err error
+			if raw, err = condition.Value.MarshalJSON(); err != nil {
+				return nil, err
+			}
+		}
+		rule, err := triggerRuleJSON(stringValue(condition.Field), stringValue(condition.Operator), raw)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+func snapshotActions(snapshot *zendesk_api.TriggerSnapshotObject) ([]string, error) {
+	if snapshot.Actions == nil {
+		return nil, nil
+	}
+	rules := make([]string, 0, len(*snapshot.Actions))
+	for _, action := range *snapshot.Actions {
+		var raw json.RawMessage
+		if action.Value != nil {
+			var err error
+			if raw, err = action.Value.MarshalJSON(); err != nil {
+				return nil, err
+			}
+		}
+		rule, err := triggerRuleJSON(stringValue(action.Field), "", raw)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+func triggerRuleJSON(field, operator string, raw json.RawMessage) (string, error) {
+	rule := triggerRule{Field: field, Operator: operator}
+	raw = bytes.TrimSpace(raw)
+	switch {
+	case len(raw) == 0 || string(raw) == "null":
+	case raw[0] == '[':
+		var items []json.RawMessage
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return "", err
+		}
+		values := make([]string, 0, len(items))
+		for _, item := range items {
+			value, err := jsonScalarToString(item)
+			if err != nil {
+				return "", err
+			}
+			values = append(values, value)
+		}
+		rule.Value = values
+	default:
+		value, err := jsonScalarToString(raw)
+		if err != nil {
+			return "", err
+		}
+		rule.Value = value
+	}
+	encoded, err := json.Marshal(rule)
+	return string(encoded), err
+}
+
+func nonEmptyString(value *string) *string {
+	if value == nil || *value == "" {
+		return nil
+	}
+	return value
+}
+
+func boolString(value *bool) *string {
+	if value == nil {
+		return nil
+	}
+	formatted := strconv.FormatBool(*value)
+	return &formatted
+}
+
+func intValue(value *int) int {
+	if value == nil {
+		return 0
+	}
+	return *value
+}
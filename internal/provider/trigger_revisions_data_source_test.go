@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gotest.tools/v3/assert"
+	"terraform-provider-zendesk/zendesk_api"
+)
+
+func TestMapTriggerRevisionsToModel(t *testing.T) {
+	var response zendesk_api.TriggerRevisionsResponse
+	assert.NilError(t, json.Unmarshal([]byte(`{"trigger_revisions": [
+		{"id": 101, "author_id": 7, "created_at": "2024-02-01T10:00:00Z", "snapshot": {
+			"title": "Route VIP", "description": null, "active": true,
+			"conditions": {"all": [{"field": "status", "operator": "is", "value": "new"},
+				{"field": "group_id", "operator": "is", "value": "42"}], "any": []},
+			"actions": [{"field": "group_id", "value": 43}]
+		}},
+		{"id": 100, "author_id": 5, "created_at": "2024-01-31T08:00:00Z", "snapshot": {
+			"title": "Route VIPs", "description": "", "active": true,
+			"conditions": {"all": [{"field": "group_id", "operator": "is", "value": 42}]},
+			"actions": [{"field": "group_id", "value": "42"}, {"field": "notification_webhook", "value": ["01HWEBHOOK", "{}"]}]
+		}}
+	]}`), &response))
+
+	revisions, err := mapTriggerRevisionsToModel(*response.TriggerRevisions)
+	assert.NilError(t, err)
+	assert.Equal(t, len(revisions), 2)
+
+	change := func(attribute, kind, value string) triggerRevisionChangeModel {
+		return triggerRevisionChangeModel{Attribute: types.StringValue(attribute), Change: types.StringValue(kind), Value: types.StringValue(value)}
+	}
+	newest := revisions[0]
+	assert.Equal(t, newest.Id.ValueString(), "101")
+	assert.Equal(t, newest.AuthorId.ValueString(), "7")
+	assert.Equal(t, newest.Description.ValueString(), "")
+	// the group condition only changed from a number to a string
+	assert.DeepEqual(t, newest.Changes, []triggerRevisionChangeModel{
+		change("title", "removed", "Route VIPs"),
+		change("title", "added", "Route VIP"),
+		change("all", "added", `{"field":"status","operator":"is","value":"new"}`),
+		change("action", "removed", `{"field":"group_id","value":"42"}`),
+		change("action", "removed", `{"field":"notification_webhook","value":["01HWEBHOOK","{}"]}`),
+		change("action", "added", `{"field":"group_id","value":"43"}`),
+	})
+
+	assert.DeepEqual(t, revisions[1].Changes, []triggerRevisionChangeModel{
+		change("title", "added", "Route VIPs"),
+		change("active", "added", "true"),
+		change("all", "added", `{"field":"group_id","operator":"is","value":"42"}`),
+		change("action", "added", `{"field":"group_id","value":"42"}`),
+		change("action", "added", `{"field":"notification_webhook","value":["01HWEBHOOK","{}"]}`),
+	})
+}
@@ -0,0 +1,245 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"net/http"
+	"strconv"
+	"strings"
+	"terraform-provider-zendesk/zendesk_api"
+)
+
+var (
+	_ datasource.DataSource                     = (*viewCountDataSource)(nil)
+	_ datasource.DataSourceWithConfigure        = (*viewCountDataSource)(nil)
+	_ datasource.DataSourceWithConfigValidators = (*viewCountDataSource)(nil)
+)
+
+// maxViewCounts is the number of views Zendesk counts in one request.
+const maxViewCounts = 20
+
+func NewViewCountDataSource() datasource.DataSource {
+	return &viewCountDataSource{}
+}
+
+type viewCountDataSource struct {
+	client *zendesk_api.SupportApi
+}
+
+type viewCountDataSourceModel struct {
+	ViewId  types.String     `tfsdk:"view_id"`
+	ViewIds types.List       `tfsdk:"view_ids"`
+	Value   types.Int64      `tfsdk:"value"`
+	Pretty  types.String     `tfsdk:"pretty"`
+	Fresh   types.Bool       `tfsdk:"fresh"`
+	Counts  []viewCountModel `tfsdk:"counts"`
+}
+
+type viewCountModel struct {
+	ViewId types.String `tfsdk:"view_id"`
+	Value  types.Int64  `tfsdk:"value"`
+	Pretty types.String `tfsdk:"pretty"`
+	Fresh  types.Bool   `tfsdk:"fresh"`
+}
+
+func (d *viewCountDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_view_count"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *viewCountDataSource) Configure(ctx context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := request.ProviderData.(zendeskProviderData)
+
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *zendeskProviderData, got: %T. Please report this issue to the provider developers.",
+				request.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.supportApi
+}
+
+func (d *viewCountDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the number of tickets in views, e.g. to assert in a `check` block that a queue does not " +
+			"grow too long. Zendesk caches the counts, so they may lag behind the tickets.",
+		Attributes: map[string]schema.Attribute{
+			"view_id": schema.StringAttribute{
+				Description: "Id of the view to count. Either `view_id` or `view_ids` must be set.",
+				Optional:    true,
+			},
+			"view_ids": schema.ListAttribute{
+				Description: fmt.Sprintf("Ids of up to %d views to count in one request. Either `view_id` or `view_ids` must be set.", maxViewCounts),
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, maxViewCounts),
+				},
+			},
+			"value": schema.Int64Attribute{
+				Description: "The number of tickets in the view of `view_id`. Null while Zendesk is still counting, and when `view_ids` is set.",
+				Computed:    true,
+			},
+			"pretty": schema.StringAttribute{
+				Description: "The number of tickets in the view of `view_id` as Zendesk displays it, e.g. `~1k`.",
+				Computed:    true,
+			},
+			"fresh": schema.BoolAttribute{
+				Description: "Whether the count of the view of `view_id` is up to date.",
+				Computed:    true,
+			},
+			"counts": schema.ListNestedAttribute{
+				Description: "The counts of the views, in the order of `view_ids`. Views the user cannot access are left out.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"view_id": schema.StringAttribute{
+							Description: "Id of the view.",
+							Computed:    true,
+						},
+						"value": schema.Int64Attribute{
+							Description: "The number of tickets in the view. Null while Zendesk is still counting.",
+							Computed:    true,
+						},
+						"pretty": schema.StringAttribute{
+							Description: "The number of tickets as Zendesk displays it, e.g. `~1k`.",
+							Computed:    true,
+						},
+						"fresh": schema.BoolAttribute{
+							Description: "Whether the count is up to date. Zendesk refreshes stale counts in the background.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *viewCountDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("view_id"), path.MatchRoot("view_ids")),
+	}
+}
+
+func (d *viewCountDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config viewCountDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.Value = types.Int64Null()
+	config.Pretty = types.StringNull()
+	config.Fresh = types.BoolNull()
+
+	if !config.ViewId.IsNull() {
+		viewId, err := strconv.Atoi(config.ViewId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("view_id"), "Invalid View Id", err.Error())
+			return
+		}
+
+		tflog.Debug(ctx, "Read view count", map[string]interface{}{"view_id": viewId})
+		reqEditors := make([]zendesk_api.RequestEditorFn, 0)
+		response, err := d.client.GetClient().GetViewCountWithResponse(ctx, viewId, reqEditors...)
+		if err != nil {
+			tflog.Error(ctx, "Error reading view count from the API: ", map[string]interface{}{"error": err})
+			resp.Diagnostics.AddError("Error reading view count from the API", err.Error())
+			return
+		}
+		if response.StatusCode() != http.StatusOK || response.JSON200 == nil || response.JSON200.ViewCount == nil {
+			tflog.Error(ctx, "API error reading view count: "+response.Status(), map[string]interface{}{"error": string(response.Body)})
+			addAPIError(&resp.Diagnostics, "Error reading view count from the API", response.HTTPResponse, response.Body, nil)
+			return
+		}
+
+		count := mapViewCountToModel(*response.JSON200.ViewCount)
+		config.Value, config.Pretty, config.Fresh = count.Value, count.Pretty, count.Fresh
+		config.Counts = []viewCountModel{count}
+		resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+		return
+	}
+
+	var viewIds []string
+	resp.Diagnostics.Append(config.ViewIds.ElementsAs(ctx, &viewIds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for i, viewId := range viewIds {
+		if _, err := strconv.Atoi(viewId); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("view_ids").AtListIndex(i), "Invalid View Id", err.Error())
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Read view counts", map[string]interface{}{"view_ids": viewIds})
+	reqEditors := make([]zendesk_api.RequestEditorFn, 0)
+	response, err := d.client.GetClient().GetViewCountsWithResponse(ctx, &zendesk_api.GetViewCountsParams{Ids: strings.Join(viewIds, ",")}, reqEditors...)
+	if err != nil {
+		tflog.Error(ctx, "Error reading view counts from the API: ", map[string]interface{}{"error": err})
+		resp.Diagnostics.AddError("Error reading view counts from the API", err.Error())
+		return
+	}
+	if response.StatusCode() != http.StatusOK || response.JSON200 == nil {
+		tflog.Error(ctx, "API error reading view counts: "+response.Status(), map[string]interface{}{"error": string(response.Body)})
+		addAPIError(&resp.Diagnostics, "Error reading view counts from the API", response.HTTPResponse, response.Body, nil)
+		return
+	}
+
+	var counts []zendesk_api.ViewCountObject
+	if response.JSON200.ViewCounts != nil {
+		counts = *response.JSON200.ViewCounts
+	}
+	config.Counts = mapViewCountsToModel(viewIds, counts)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// mapViewCountsToModel orders the counts like the requested views, Zendesk answers in any order.
+func mapViewCountsToModel(viewIds []string, counts []zendesk_api.ViewCountObject) []viewCountModel {
+	byViewId := make(map[string]zendesk_api.ViewCountObject, len(counts))
+	for _, count := range counts {
+		if count.ViewId != nil {
+			byViewId[strconv.Itoa(*count.ViewId)] = count
+		}
+	}
+
+	models := make([]viewCountModel, 0, len(counts))
+	for _, viewId := range viewIds {
+		if count, ok := byViewId[viewId]; ok {
+			models = append(models, mapViewCountToModel(count))
+		}
+	}
+	return models
+}
+
+func mapViewCountToModel(count zendesk_api.ViewCountObject) viewCountModel {
+	model := viewCountModel{
+		ViewId: types.StringNull(),
+		Value:  int64PointerValue(count.Value),
+		Pretty: types.StringPointerValue(count.Pretty),
+		Fresh:  types.BoolPointerValue(count.Fresh),
+	}
+	if count.ViewId != nil {
+		model.ViewId = types.StringValue(strconv.Itoa(*count.ViewId))
+	}
+	return model
+}
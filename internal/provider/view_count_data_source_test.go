@@ -0,0 +1,26 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gotest.tools/v3/assert"
+	"terraform-provider-zendesk/zendesk_api"
+)
+
+func TestMapViewCountsToModel(t *testing.T) {
+	var response zendesk_api.ViewCountsResponse
+	assert.NilError(t, json.Unmarshal([]byte(`{"view_counts": [
+		{"view_id": 26, "value": null, "pretty": "...", "fresh": false, "active": true},
+		{"view_id": 25, "value": 719, "pretty": "~700", "fresh": true, "active": true}
+	]}`), &response))
+
+	// the view the user cannot access is left out, the others keep the requested order
+	counts := mapViewCountsToModel([]string{"25", "24", "26"}, *response.ViewCounts)
+
+	assert.DeepEqual(t, counts, []viewCountModel{
+		{ViewId: types.StringValue("25"), Value: types.Int64Value(719), Pretty: types.StringValue("~700"), Fresh: types.BoolValue(true)},
+		{ViewId: types.StringValue("26"), Value: types.Int64Null(), Pretty: types.StringValue("..."), Fresh: types.BoolValue(false)},
+	})
+}
@@ -0,0 +1,707 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"net/http"
+	"strconv"
+	"strings"
+	"terraform-provider-zendesk/zendesk_api"
+	"time"
+)
+
+var (
+	_ resource.Resource                   = (*viewResource)(nil)
+	_ resource.ResourceWithConfigure      = (*viewResource)(nil)
+	_ resource.ResourceWithImportState    = (*viewResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*viewResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*viewResource)(nil)
+)
+
+const (
+	viewRestrictionGroup = "Group"
+	viewRestrictionUser  = "User"
+)
+
+func NewViewResource() resource.Resource {
+	return &viewResource{}
+}
+
+type viewResource struct {
+	client *zendesk_api.SupportApi
+}
+
+type viewResourceModel struct {
+	Id          types.String            `tfsdk:"id"`
+	Title       types.String            `tfsdk:"title"`
+	Description types.String            `tfsdk:"description"`
+	Active      types.Bool              `tfsdk:"active"`
+	Position    types.Int64             `tfsdk:"position"`
+	Default     types.Bool              `tfsdk:"default"`
+	Output      *viewOutputModel        `tfsdk:"output"`
+	Restriction *viewRestrictionModel   `tfsdk:"restriction"`
+	CreatedAt   types.String            `tfsdk:"created_at"`
+	UpdatedAt   types.String            `tfsdk:"updated_at"`
+	All         []triggerConditionModel `tfsdk:"all"`
+	Any         []triggerConditionModel `tfsdk:"any"`
+}
+
+type viewOutputModel struct {
+	Columns    types.List   `tfsdk:"columns"`
+	GroupBy    types.String `tfsdk:"group_by"`
+	GroupOrder types.String `tfsdk:"group_order"`
+	SortBy     types.String `tfsdk:"sort_by"`
+	SortOrder  types.String `tfsdk:"sort_order"`
+}
+
+type viewRestrictionModel struct {
+	Type types.String `tfsdk:"type"`
+	Ids  types.List   `tfsdk:"ids"`
+}
+
+// viewAttributePaths maps the fields of validation errors of the view API to the Terraform paths.
+func viewAttributePaths(field string) (path.Path, bool) {
+	switch field = strings.TrimPrefix(field, "view."); field {
+	case "title", "description", "active", "position", "restriction", "all", "any":
+		return path.Root(field), true
+	case "output":
+		return path.Root("output").AtName("columns"), true
+	}
+	return path.Empty(), false
+}
+
+func (r *viewResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_view"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *viewResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := request.ProviderData.(zendeskProviderData)
+
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *zendeskProviderData, got: %T. Please report this issue to the provider developers.",
+				request.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.supportApi
+}
+
+func (r *viewResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	orderValidators := []validator.String{stringvalidator.OneOf("asc", "desc")}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a shared view. The conditions are previewed by Zendesk while planning, so that a view " +
+			"Zendesk would reject fails the plan. See the [conditions reference](https://developer.zendesk.com/documentation/ticketing/reference-guides/conditions-reference/).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Id of the view.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"title": schema.StringAttribute{
+				Description: "The title of the view.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the view.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"active": schema.BoolAttribute{
+				Description: "Whether the view is displayed to the agents. Defaults to `true`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"position": schema.Int64Attribute{
+				Description: "Position of the view in the list of views. Zendesk puts new views last when not set.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"default": schema.BoolAttribute{
+				Description: "Whether the view is a default view of the account.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"output": schema.SingleNestedAttribute{
+				Description: "The columns of the view and how its tickets are grouped and sorted. Zendesk chooses the " +
+					"grouping and sorting when not set.",
+				Required: true,
+				Attributes: map[string]schema.Attribute{
+					"columns": schema.ListAttribute{
+						Description: "The columns to display, system columns by name like `status` or `requester`, " +
+							"custom fields by their id.",
+						ElementType: types.StringType,
+						Required:    true,
+						Validators: []validator.List{
+							listvalidator.SizeBetween(1, 10),
+						},
+					},
+					"group_by": schema.StringAttribute{
+						Description: "The column the tickets are grouped by.",
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"group_order": schema.StringAttribute{
+						Description: "The direction the tickets are grouped in, `asc` or `desc`.",
+						Optional:    true,
+						Computed:    true,
+						Validators:  orderValidators,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"sort_by": schema.StringAttribute{
+						Description: "The column the tickets are sorted by.",
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"sort_order": schema.StringAttribute{
+						Description: "The direction the tickets are sorted in, `asc` or `desc`.",
+						Optional:    true,
+						Computed:    true,
+						Validators:  orderValidators,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+			"restriction": schema.SingleNestedAttribute{
+				Description: "Restricts the view to groups or to a user. All agents can use the view when not set.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Description: "`Group` or `User`.",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(viewRestrictionGroup, viewRestrictionUser),
+						},
+					},
+					"ids": schema.ListAttribute{
+						Description: "Ids of the groups, or the id of the user.",
+						ElementType: types.StringType,
+						Required:    true,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+					},
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The time the view was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "The time of the last update of the view.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"all": triggerConditionBlock("Logical AND. Tickets must meet all the conditions. Zendesk requires one on " +
+				"`status`, `type`, `group_id`, `assignee_id` or `requester_id`."),
+			"any": triggerConditionBlock("Logical OR. Tickets must meet any of the conditions."),
+		},
+	}
+}
+
+func (r *viewResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config viewResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(config.All) == 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("all"), "Missing View Conditions",
+			"A view needs at least one all condition.")
+	}
+	if config.Restriction != nil && config.Restriction.Type.ValueString() == viewRestrictionUser &&
+		!config.Restriction.Ids.IsUnknown() && len(config.Restriction.Ids.Elements()) > 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("restriction").AtName("ids"), "Invalid View Restriction",
+			"A view is restricted to one user only.")
+	}
+}
+
+// ModifyPlan previews the conditions of new and changed views with Zendesk, which rejects conditions it would not
+// save. The preview only counts the matching tickets.
+func (r *viewResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan viewResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !req.State.Raw.IsNull() {
+		var state viewResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if viewConditionsEqual(plan.All, state.All) && viewConditionsEqual(plan.Any, state.Any) {
+			return
+		}
+	}
+	if !viewConditionsKnown(plan.All) || !viewConditionsKnown(plan.Any) {
+		return
+	}
+
+	var diags diag.Diagnostics
+	request := zendesk_api.ViewRequest{}
+	request.View.All = mapViewConditions(ctx, plan.All, &diags)
+	request.View.Any = mapViewConditions(ctx, plan.Any, &diags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	body, err := json.Marshal(request)
+	if err != nil {
+		resp.Diagnostics.AddError("Error mapping view conditions to the API request", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Preview view conditions", map[string]interface{}{"title": plan.Title.ValueString()})
+	reqEditors := make([]zendesk_api.RequestEditorFn, 0)
+	response, err := r.client.GetClient().PreviewCountWithBodyWithResponse(ctx, "application/json", bytes.NewReader(body), reqEditors...)
+	if err != nil {
+		tflog.Warn(ctx, "Error previewing view conditions, the conditions are not validated", map[string]interface{}{"error": err})
+		resp.Diagnostics.AddWarning("View conditions not validated",
+			"The view could not be previewed, so the conditions are only validated by Zendesk when applying: "+err.Error())
+		return
+	}
+	if response.StatusCode() != http.StatusOK {
+		tflog.Error(ctx, "API error previewing view conditions: "+response.Status(), map[string]interface{}{"error": string(response.Body)})
+		addAPIError(&resp.Diagnostics, "Invalid view conditions", response.HTTPResponse, response.Body, viewAttributePaths)
+		return
+	}
+	if response.JSON200 != nil && response.JSON200.ViewCount != nil {
+		tflog.Debug(ctx, "Previewed view conditions", map[string]interface{}{"tickets": intValue(response.JSON200.ViewCount.Value)})
+	}
+}
+
+func viewConditionsEqual(a, b []triggerConditionModel) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Field.Equal(b[i].Field) || !a[i].Operator.Equal(b[i].Operator) ||
+			!a[i].Value.Equal(b[i].Value) || !a[i].Values.Equal(b[i].Values) {
+			return false
+		}
+	}
+	return true
+}
+
+func viewConditionsKnown(conditions []triggerConditionModel) bool {
+	for _, condition := range conditions {
+		if condition.Field.IsUnknown() || condition.Operator.IsUnknown() || condition.Value.IsUnknown() || condition.Values.IsUnknown() {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *viewResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan viewResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := mapViewModelToRequestBody(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Create view", map[string]interface{}{"title": plan.Title.ValueString()})
+	reqEditors := make([]zendesk_api.RequestEditorFn, 0)
+	response, err := r.client.GetClient().CreateViewWithBodyWithResponse(ctx, "application/json", bytes.NewReader(body), reqEditors...)
+	if err != nil {
+		tflog.Error(ctx, "Error creating view: ", map[string]interface{}{"error": err})
+		resp.Diagnostics.AddError("Error creating view", err.Error())
+		return
+	}
+	if response.StatusCode() != http.StatusOK || response.JSON200 == nil || response.JSON200.View == nil {
+		tflog.Error(ctx, "API error creating view: "+response.Status(), map[string]interface{}{"error": string(response.Body)})
+		addAPIError(&resp.Diagnostics, "Error creating view", response.HTTPResponse, response.Body, viewAttributePaths)
+		return
+	}
+
+	resp.Diagnostics.Append(mapViewToModel(ctx, response.JSON200.View, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *viewResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state viewResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	viewId, err := strconv.Atoi(state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("id"), "Invalid View Id", err.Error())
+		return
+	}
+
+	reqEditors := make([]zendesk_api.RequestEditorFn, 0)
+	response, err := r.client.GetClient().ShowViewWithResponse(ctx, viewId, reqEditors...)
+	if err != nil {
+		tflog.Error(ctx, "Error reading view: ", map[string]interface{}{"error": err})
+		resp.Diagnostics.AddError("Error reading view", err.Error())
+		return
+	}
+	if removeResourceWhenNotFound(ctx, response.StatusCode(), "zendesk_view", state.Id.ValueString(), resp) {
+		return
+	}
+	if response.StatusCode() != http.StatusOK || response.JSON200 == nil || response.JSON200.View == nil {
+		tflog.Error(ctx, "API error reading view: "+response.Status(), map[string]interface{}{"error": string(response.Body)})
+		addAPIError(&resp.Diagnostics, "Error reading view", response.HTTPResponse, response.Body, nil)
+		return
+	}
+
+	resp.Diagnostics.Append(mapViewToModel(ctx, response.JSON200.View, &state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *viewResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state viewResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	viewId, err := strconv.Atoi(state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("id"), "Invalid View Id", err.Error())
+		return
+	}
+
+	body, diags := mapViewModelToRequestBody(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Update view", map[string]interface{}{"id": viewId})
+	reqEditors := make([]zendesk_api.RequestEditorFn, 0)
+	response, err := r.client.GetClient().UpdateViewWithBodyWithResponse(ctx, viewId, "application/json", bytes.NewReader(body), reqEditors...)
+	if err != nil {
+		tflog.Error(ctx, "Error updating view: ", map[string]interface{}{"error": err})
+		resp.Diagnostics.AddError("Error updating view", err.Error())
+		return
+	}
+	if response.StatusCode() != http.StatusOK || response.JSON200 == nil || response.JSON200.View == nil {
+		tflog.Error(ctx, "API error updating view: "+response.Status(), map[string]interface{}{"error": string(response.Body)})
+		addAPIError(&resp.Diagnostics, "Error updating view", response.HTTPResponse, response.Body, viewAttributePaths)
+		return
+	}
+
+	resp.Diagnostics.Append(mapViewToModel(ctx, response.JSON200.View, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *viewResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state viewResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	viewId, err := strconv.Atoi(state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("id"), "Invalid View Id", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "Delete view", map[string]interface{}{"id": viewId})
+	reqEditors := make([]zendesk_api.RequestEditorFn, 0)
+	response, err := r.client.GetClient().DeleteViewWithResponse(ctx, viewId, reqEditors...)
+	if err != nil {
+		tflog.Error(ctx, "Error deleting view: ", map[string]interface{}{"error": err})
+		resp.Diagnostics.AddError("Error deleting view", err.Error())
+		return
+	}
+	if response.StatusCode() != http.StatusNoContent && response.StatusCode() != http.StatusNotFound {
+		tflog.Error(ctx, "API error deleting view: "+response.Status(), map[string]interface{}{"error": string(response.Body)})
+		addAPIError(&resp.Diagnostics, "Error deleting view", response.HTTPResponse, response.Body, nil)
+	}
+}
+
+// ImportState imports a view by its numeric id.
+func (r *viewResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if _, err := strconv.Atoi(req.ID); err != nil {
+		resp.Diagnostics.AddError("Invalid View Id", fmt.Sprintf("A view is imported by its numeric id, got %q", req.ID))
+		return
+	}
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// mapViewConditions maps the conditions to the API, with the values as strings like the trigger conditions.
+func mapViewConditions(ctx context.Context, conditions []triggerConditionModel, diags *diag.Diagnostics) *[]zendesk_api.ViewConditionObject {
+	objects := make([]zendesk_api.ViewConditionObject, 0, len(conditions))
+	for _, condition := range conditions {
+		object := zendesk_api.ViewConditionObject{
+			Field:    condition.Field.ValueStringPointer(),
+			Operator: condition.Operator.ValueStringPointer(),
+		}
+		raw, valueDiags := ruleValueToJSON(ctx, condition.Value, condition.Values)
+		diags.Append(valueDiags...)
+		if raw != nil {
+			var value interface{} = raw
+			object.Value = &value
+		}
+		objects = append(objects, object)
+	}
+	return &objects
+}
+
+func mapViewModelToRequestBody(ctx context.Context, model viewResourceModel) ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	view := zendesk_api.ViewInput{
+		Title:       model.Title.ValueStringPointer(),
+		Description: model.Description.ValueStringPointer(),
+		Active:      model.Active.ValueBoolPointer(),
+		All:         mapViewConditions(ctx, model.All, &diags),
+		Any:         mapViewConditions(ctx, model.Any, &diags),
+	}
+	if !model.Position.IsNull() && !model.Position.IsUnknown() {
+		position := int(model.Position.ValueInt64())
+		view.Position = &position
+	}
+
+	if model.Output != nil {
+		var columnNames []string
+		diags.Append(model.Output.Columns.ElementsAs(ctx, &columnNames, false)...)
+		// custom fields are columns by their numeric id
+		columns := make([]interface{}, 0, len(columnNames))
+		for _, column := range columnNames {
+			if id, err := strconv.Atoi(column); err == nil {
+				columns = append(columns, id)
+			} else {
+				columns = append(columns, column)
+			}
+		}
+		view.Output = &zendesk_api.ViewOutputObject{Columns: &columns}
+		knownString := func(value types.String) *string {
+			if value.IsNull() || value.IsUnknown() {
+				return nil
+			}
+			return value.ValueStringPointer()
+		}
+		view.Output.GroupBy = knownString(model.Output.GroupBy)
+		view.Output.GroupOrder = knownString(model.Output.GroupOrder)
+		view.Output.SortBy = knownString(model.Output.SortBy)
+		view.Output.SortOrder = knownString(model.Output.SortOrder)
+	}
+
+	// a null restriction opens the view to all agents again
+	if model.Restriction != nil {
+		var ids []string
+		diags.Append(model.Restriction.Ids.ElementsAs(ctx, &ids, false)...)
+		restriction := zendesk_api.ViewRestrictionObject{Type: model.Restriction.Type.ValueStringPointer()}
+		numericIds := make([]int, 0, len(ids))
+		for _, id := range ids {
+			numericId, err := strconv.Atoi(id)
+			if err != nil {
+				diags.AddAttributeError(path.Root("restriction").AtName("ids"), "Invalid View Restriction",
+					fmt.Sprintf("The ids of groups and users are numeric, got %q", id))
+				continue
+			}
+			numericIds = append(numericIds, numericId)
+		}
+		if model.Restriction.Type.ValueString() == viewRestrictionUser && len(numericIds) > 0 {
+			restriction.Id = &numericIds[0]
+		} else {
+			restriction.Ids = &numericIds
+		}
+		view.Restriction = &restriction
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	body, err := json.Marshal(zendesk_api.ViewRequest{View: view})
+	if err != nil {
+		diags.AddError("Error mapping view to the API request", err.Error())
+	}
+	return body, diags
+}
+
+func mapViewToModel(ctx context.Context, view *zendesk_api.ViewObject, model *viewResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if view.Id != nil {
+		model.Id = types.StringValue(strconv.Itoa(*view.Id))
+	}
+	model.Title = types.StringPointerValue(view.Title)
+	model.Description = types.StringValue(stringValue(view.Description))
+	model.Active = types.BoolPointerValue(view.Active)
+	model.Position = int64PointerValue(view.Position)
+	model.Default = types.BoolPointerValue(view.Default)
+	model.CreatedAt = timeValue(view.CreatedAt)
+	model.UpdatedAt = timeValue(view.UpdatedAt)
+
+	mapConditions := func(objects *[]zendesk_api.ViewConditionObject) []triggerConditionModel {
+		if objects == nil || len(*objects) == 0 {
+			return nil
+		}
+		conditions := make([]triggerConditionModel, 0, len(*objects))
+		for _, object := range *objects {
+			condition := triggerConditionModel{
+				Field:    types.StringPointerValue(object.Field),
+				Operator: types.StringPointerValue(object.Operator),
+				Value:    types.StringNull(),
+				Values:   types.ListNull(types.StringType),
+			}
+			if object.Value != nil {
+				raw, err := json.Marshal(*object.Value)
+				if err != nil {
+					diags.AddError("Error mapping view condition", err.Error())
+					continue
+				}
+				condition.Value, condition.Values, err = ruleValueFromJSON(raw)
+				if err != nil {
+					diags.AddError("Error mapping view condition", err.Error())
+				}
+			}
+			conditions = append(conditions, condition)
+		}
+		return conditions
+	}
+	model.All, model.Any = nil, nil
+	if view.Conditions != nil {
+		model.All = mapConditions(view.Conditions.All)
+		model.Any = mapConditions(view.Conditions.Any)
+	}
+
+	output := &viewOutputModel{
+		Columns:    types.ListValueMust(types.StringType, nil),
+		GroupBy:    types.StringNull(),
+		GroupOrder: types.StringNull(),
+		SortBy:     types.StringNull(),
+		SortOrder:  types.StringNull(),
+	}
+	if execution := view.Execution; execution != nil {
+		if execution.Columns != nil {
+			columns := make([]string, 0, len(*execution.Columns))
+			for _, column := range *execution.Columns {
+				id, err := viewColumnId(column.Id)
+				if err != nil {
+					diags.AddError("Error mapping view column", err.Error())
+					continue
+				}
+				columns = append(columns, id)
+			}
+			var listDiags diag.Diagnostics
+			output.Columns, listDiags = types.ListValueFrom(ctx, types.StringType, columns)
+			diags.Append(listDiags...)
+		}
+		output.GroupBy, output.GroupOrder = viewOrder(execution.GroupBy, execution.GroupOrder, execution.Group)
+		output.SortBy, output.SortOrder = viewOrder(execution.SortBy, execution.SortOrder, execution.Sort)
+	}
+	model.Output = output
+
+	model.Restriction = nil
+	if restriction := view.Restriction; restriction != nil && restriction.Type != nil {
+		ids := make([]string, 0)
+		if restriction.Ids != nil && len(*restriction.Ids) > 0 {
+			for _, id := range *restriction.Ids {
+				ids = append(ids, strconv.Itoa(id))
+			}
+		} else if restriction.Id != nil {
+			ids = append(ids, strconv.Itoa(*restriction.Id))
+		}
+		idList, listDiags := types.ListValueFrom(ctx, types.StringType, ids)
+		diags.Append(listDiags...)
+		model.Restriction = &viewRestrictionModel{Type: types.StringPointerValue(restriction.Type), Ids: idList}
+	}
+
+	tflog.Debug(ctx, "Mapped view", map[string]interface{}{"id": model.Id.ValueString()})
+	return diags
+}
+
+// viewColumnId is the name of a system column or the id of a custom field column.
+func viewColumnId(id *interface{}) (string, error) {
+	if id == nil {
+		return "", fmt.Errorf("a view column has no id")
+	}
+	raw, err := json.Marshal(*id)
+	if err != nil {
+		return "", err
+	}
+	return jsonScalarToString(raw)
+}
+
+// viewOrder is the column and direction of the grouping or sorting. Zendesk reports them in the by and order
+// fields, or only in the group and sort objects.
+func viewOrder(by, order *string, object *zendesk_api.ViewExecutionOrderObject) (types.String, types.String) {
+	column, direction := types.StringPointerValue(by), types.StringPointerValue(order)
+	if object == nil {
+		return column, direction
+	}
+	if column.IsNull() && object.Id != nil {
+		if id, err := viewColumnId(object.Id); err == nil {
+			column = types.StringValue(id)
+		}
+	}
+	if direction.IsNull() {
+		direction = types.StringPointerValue(object.Order)
+	}
+	return column, direction
+}
+
+func timeValue(value *time.Time) types.String {
+	if value == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(value.Format(time.RFC3339))
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gotest.tools/v3/assert"
+	"terraform-provider-zendesk/zendesk_api"
+)
+
+func TestMapViewModelToRequestBody(t *testing.T) {
+	ctx := context.Background()
+	columns, _ := types.ListValueFrom(ctx, types.StringType, []string{"status", "requester", "360011872073"})
+	groups, _ := types.ListValueFrom(ctx, types.StringType, []string{"10052", "10057"})
+	statuses, _ := types.ListValueFrom(ctx, types.StringType, []string{"1", "2"})
+
+	model := viewResourceModel{
+		Title:       types.StringValue("VIP queue"),
+		Description: types.StringValue(""),
+		Active:      types.BoolValue(true),
+		Position:    types.Int64Unknown(),
+		Output: &viewOutputModel{
+			Columns:    columns,
+			GroupBy:    types.StringValue("assignee"),
+			GroupOrder: types.StringUnknown(),
+			SortBy:     types.StringNull(),
+			SortOrder:  types.StringValue("desc"),
+		},
+		Restriction: &viewRestrictionModel{Type: types.StringValue("Group"), Ids: groups},
+		All: []triggerConditionModel{
+			{Field: types.StringValue("status"), Operator: types.StringValue("less_than"), Value: types.StringValue("solved"), Values: types.ListNull(types.StringType)},
+		},
+		Any: []triggerConditionModel{
+			{Field: types.StringValue("custom_status_id"), Operator: types.StringValue("includes"), Value: types.StringNull(), Values: statuses},
+		},
+	}
+
+	body, diags := mapViewModelToRequestBody(ctx, model)
+	assert.Assert(t, !diags.HasError(), diags)
+	assert.Equal(t, string(body), `{"view":{"active":true,`+
+		`"all":[{"field":"status","operator":"less_than","value":"solved"}],`+
+		`"any":[{"field":"custom_status_id","operator":"includes","value":["1","2"]}],"description":"",`+
+		`"output":{"columns":["status","requester",360011872073],"group_by":"assignee","sort_order":"desc"},`+
+		`"restriction":{"ids":[10052,10057],"type":"Group"},"title":"VIP queue"}}`)
+
+	// without a restriction, the view is opened to all agents again
+	model.Restriction = nil
+	body, diags = mapViewModelToRequestBody(ctx, model)
+	assert.Assert(t, !diags.HasError(), diags)
+	var request map[string]map[string]json.RawMessage
+	assert.NilError(t, json.Unmarshal(body, &request))
+	assert.Equal(t, string(request["view"]["restriction"]), "null")
+}
+
+func TestMapViewToModel(t *testing.T) {
+	var response zendesk_api.ViewResponse
+	assert.NilError(t, json.Unmarshal([]byte(`{"view": {
+		"id": 25, "title": "VIP queue", "description": null, "active": true, "position": 8, "default": false,
+		"conditions": {"all": [{"field": "status", "operator": "less_than", "value": "solved"},
+			{"field": "assignee_id", "operator": "is", "value": 296220096}], "any": []},
+		"execution": {
+			"columns": [{"id": "status", "title": "Status"}, {"id": 5, "title": "Account"}],
+			"group_by": null, "group": {"id": "status", "order": "desc", "title": "Status"},
+			"sort_by": "updated", "sort_order": "asc"
+		},
+		"restriction": {"id": 4, "type": "User"},
+		"created_at": "2024-01-31T08:00:00Z", "updated_at": "2024-02-01T10:00:00Z"
+	}}`), &response))
+
+	var model viewResourceModel
+	diags := mapViewToModel(context.Background(), response.View, &model)
+	assert.Assert(t, !diags.HasError(), diags)
+
+	assert.Equal(t, model.Id.ValueString(), "25")
+	assert.Equal(t, model.Description.ValueString(), "")
+	assert.Equal(t, model.Position.ValueInt64(), int64(8))
+	assert.Equal(t, model.CreatedAt.ValueString(), "2024-01-31T08:00:00Z")
+	assert.Equal(t, len(model.All), 2)
+	assert.Equal(t, model.All[1].Value.ValueString(), "296220096")
+	assert.Assert(t, model.Any == nil)
+
+	assert.Equal(t, model.Output.Columns.String(), `["status","5"]`)
+	assert.Equal(t, model.Output.GroupBy.ValueString(), "status")
+	assert.Equal(t, model.Output.GroupOrder.ValueString(), "desc")
+	assert.Equal(t, model.Output.SortBy.ValueString(), "updated")
+	assert.Equal(t, model.Output.SortOrder.ValueString(), "asc")
+
+	assert.Equal(t, model.Restriction.Type.ValueString(), "User")
+	assert.Equal(t, model.Restriction.Ids.String(), `["4"]`)
+}
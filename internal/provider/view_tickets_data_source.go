@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strconv"
+	"terraform-provider-zendesk/zendesk_api"
+)
+
+var (
+	_ datasource.DataSource              = (*viewTicketsDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*viewTicketsDataSource)(nil)
+)
+
+// viewTicketsPageSize is the largest page the view tickets endpoint returns.
+const viewTicketsPageSize = 100
+
+func NewViewTicketsDataSource() datasource.DataSource {
+	return &viewTicketsDataSource{}
+}
+
+type viewTicketsDataSource struct {
+	client *zendesk_api.SupportApi
+}
+
+type viewTicketsDataSourceModel struct {
+	ViewId    types.String `tfsdk:"view_id"`
+	SortBy    types.String `tfsdk:"sort_by"`
+	SortOrder types.String `tfsdk:"sort_order"`
+	Limit     types.Int64  `tfsdk:"limit"`
+	TicketIds types.List   `tfsdk:"ticket_ids"`
+}
+
+func (d *viewTicketsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_view_tickets"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *viewTicketsDataSource) Configure(ctx context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := request.ProviderData.(zendeskProviderData)
+
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *zendeskProviderData, got: %T. Please report this issue to the provider developers.",
+				request.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.supportApi
+}
+
+func (d *viewTicketsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the ids of the tickets in a view, e.g. to assert in a `check` block that a queue is empty.",
+		Attributes: map[string]schema.Attribute{
+			"view_id": schema.StringAttribute{
+				Description: "Id of the view.",
+				Required:    true,
+			},
+			"sort_by": schema.StringAttribute{
+				Description: "Column to sort the tickets by instead of the sorting of the view. " +
+					"The `subject` and `submitter` columns are not supported.",
+				Optional: true,
+			},
+			"sort_order": schema.StringAttribute{
+				Description: "Either `asc` or `desc`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("asc", "desc"),
+				},
+			},
+			"limit": schema.Int64Attribute{
+				Description: "The maximum number of tickets to list. All tickets of the view are listed when unset.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"ticket_ids": schema.ListAttribute{
+				Description: "Ids of the tickets in the view, in the order of the view.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *viewTicketsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config viewTicketsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	viewId, err := strconv.Atoi(config.ViewId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("view_id"), "Invalid View Id", err.Error())
+		return
+	}
+
+	params := zendesk_api.ListTicketsFromViewParams{
+		SortBy:    config.SortBy.ValueStringPointer(),
+		SortOrder: config.SortOrder.ValueStringPointer(),
+	}
+
+	tflog.Debug(ctx, "Read view tickets", map[string]interface{}{"view_id": viewId})
+	ticketIds, err := listViewTicketIds(ctx, d.client, viewId, &params, int(config.Limit.ValueInt64()))
+	if err != nil {
+		tflog.Error(ctx, "Error reading view tickets from the API: ", map[string]interface{}{"error": err})
+		resp.Diagnostics.AddError("Error reading view tickets from the API", err.Error())
+		return
+	}
+
+	ticketIdList, diags := types.ListValueFrom(ctx, types.StringType, ticketIds)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	config.TicketIds = ticketIdList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// listViewTicketIds lists the ids of the tickets in the view from all pages, or only the first limit of them when the
+// limit is positive.
+func listViewTicketIds(ctx context.Context, client *zendesk_api.SupportApi, viewId int, params *zendesk_api.ListTicketsFromViewParams, limit int) ([]string, error) {
+	pageSize := viewTicketsPageSize
+	if limit > 0 && limit < pageSize {
+		pageSize = limit
+	}
+	params.PageSize = &pageSize
+
+	ticketIds := make([]string, 0)
+	err := client.ForEachViewTicket(ctx, viewId, params, func(ticket zendesk_api.TicketObject) bool {
+		if ticket.Id != nil {
+			ticketIds = append(ticketIds, strconv.Itoa(*ticket.Id))
+		}
+		return limit <= 0 || len(ticketIds) < limit
+	})
+	return ticketIds, err
+}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	"terraform-provider-zendesk/zendesk_api"
+	"terraform-provider-zendesk/zendesk_http"
+)
+
+func TestListViewTicketIds(t *testing.T) {
+	var server *httptest.Server
+	var pageSizes []string
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.URL.Path, "/api/v2/views/25/tickets")
+		pageSizes = append(pageSizes, r.URL.Query().Get("page[size]"))
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("page[after]") {
+		case "":
+			assert.Equal(t, r.URL.Query().Get("sort_order"), "desc")
+			_, _ = w.Write([]byte(`{"tickets":[{"id":3},{"id":2}],
+				"meta":{"has_more":true,"after_cursor":"c1"},
+				"links":{"next":"` + server.URL + `/api/v2/views/25/tickets?page%5Bafter%5D=c1&page%5Bsize%5D=` + r.URL.Query().Get("page[size]") + `&sort_order=desc"}}`))
+		case "c1":
+			_, _ = w.Write([]byte(`{"tickets":[{"id":1}],"meta":{"has_more":false}}`))
+		default:
+			t.Errorf("unexpected request %s", r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := zendesk_api.NewSupportApi(server.URL, zendesk_http.NewBearerTokenAuthenticator("t"), nil)
+	assert.NilError(t, err)
+
+	sortOrder := "desc"
+	ticketIds, err := listViewTicketIds(context.Background(), client, 25, &zendesk_api.ListTicketsFromViewParams{SortOrder: &sortOrder}, 0)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, ticketIds, []string{"3", "2", "1"})
+	assert.DeepEqual(t, pageSizes, []string{"100", "100"})
+
+	// the limit caps the page size and stops before the next page
+	pageSizes = nil
+	ticketIds, err = listViewTicketIds(context.Background(), client, 25, &zendesk_api.ListTicketsFromViewParams{SortOrder: &sortOrder}, 2)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, ticketIds, []string{"3", "2"})
+	assert.DeepEqual(t, pageSizes, []string{"2"})
+}
+
+func TestListViewTicketIdsFails(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error":"RecordNotFound"}`))
+	}))
+	defer server.Close()
+
+	client, err := zendesk_api.NewSupportApi(server.URL, zendesk_http.NewBearerTokenAuthenticator("t"), nil)
+	assert.NilError(t, err)
+
+	_, err = listViewTicketIds(context.Background(), client, 25, &zendesk_api.ListTicketsFromViewParams{}, 0)
+	assert.ErrorContains(t, err, "listing the tickets of view 25, StatusCode: 404")
+}
@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strings"
+	"terraform-provider-zendesk/internal/datasource_webhook"
+	"terraform-provider-zendesk/zendesk_webhook_api"
+)
+
+var (
+	_ datasource.DataSource                     = (*webhookDataSource)(nil)
+	_ datasource.DataSourceWithConfigure        = (*webhookDataSource)(nil)
+	_ datasource.DataSourceWithConfigValidators = (*webhookDataSource)(nil)
+)
+
+func NewWebhookDataSource() datasource.DataSource {
+	return &webhookDataSource{}
+}
+
+type webhookDataSource struct {
+	client *zendesk_webhook_api.WebhookApi
+}
+
+// webhookDataSourceModel extends the generated datasource_webhook.WebhookModel with the lookup by name.
+type webhookDataSourceModel struct {
+	Webhook   datasource_webhook.WebhookValue `tfsdk:"webhook"`
+	WebhookId types.String                    `tfsdk:"webhook_id"`
+	Name      types.String                    `tfsdk:"name"`
+}
+
+func (d *webhookDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *webhookDataSource) Configure(ctx context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := request.ProviderData.(zendeskProviderData)
+
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *zendeskProviderData, got: %T. Please report this issue to the provider developers.",
+				request.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.webhookApi
+}
+
+func (d *webhookDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_webhook.WebhookDataSourceSchema(ctx)
+	resp.Schema.Description = "Looks up a webhook by its id or by its name."
+	resp.Schema.Attributes["webhook_id"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "Id of the webhook. Either `webhook_id` or `name` must be set.",
+	}
+	resp.Schema.Attributes["name"] = schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "Exact name of the webhook. Either `webhook_id` or `name` must be set, the name must match exactly one webhook.",
+	}
+}
+
+func (d *webhookDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("webhook_id"), path.MatchRoot("name")),
+	}
+}
+
+func (d *webhookDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config webhookDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webhookId := config.WebhookId.ValueString()
+	if webhookId == "" {
+		tflog.Debug(ctx, "Read webhook data source by name", map[string]any{"name": config.Name.ValueString()})
+		var err error
+		webhookId, err = findWebhookIdByName(ctx, d.client, config.Name.ValueString())
+		if err != nil {
+			tflog.Error(ctx, "Error finding the webhook by name: ", map[string]interface{}{"error": err})
+			resp.Diagnostics.AddAttributeError(path.Root("name"), "Webhook Not Found", err.Error())
+			return
+		}
+	}
+
+	tflog.Debug(ctx, "Read webhook data source", map[string]any{"webhook_id": webhookId})
+	reqEditors := make([]zendesk_webhook_api.RequestEditorFn, 0)
+	webhookResponse, err := d.client.GetClient().ShowWebhookWithResponse(ctx, webhookId, reqEditors...)
+	if err != nil {
+		tflog.Error(ctx, "Error reading webhook data from the API: ", map[string]interface{}{"error": err})
+		resp.Diagnostics.AddError("Error reading webhook data from the API", err.Error())
+		return
+	}
+
+	if webhookResponse.StatusCode() != 200 {
+		message := fmt.Sprintf("Error reading webhook data from the API, StatusCode: %v , Request Param: %v", webhookResponse.StatusCode(), webhookId)
+		tflog.Error(ctx, message, map[string]interface{}{"error": string(webhookResponse.Body)})
+		resp.Diagnostics.AddError("Error reading webhook data from the API", fmt.Sprintf("%+v", string(webhookResponse.Body)))
+		return
+	}
+
+	if webhookResponse.JSON200.Webhook.SigningSecret == nil {
+		webhookResponse.JSON200.Webhook.SigningSecret = &struct {
+			Algorithm *string `json:"algorithm,omitempty"`
+			Secret    *string `json:"secret,omitempty"`
+		}{}
+	}
+	fetchSigningSecret(ctx, d.client, webhookId, webhookResponse.JSON200.Webhook.SigningSecret)
+
+	resp.Diagnostics.Append(datasource_webhook.PutWebhookShowResponseToDataSourceModel(ctx, webhookResponse, &config.WebhookId, &config.Webhook)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	config.Name = config.Webhook.Name
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// findWebhookIdByName pages through the webhooks filtered by the name and returns the id of the
+// only webhook with exactly this name.
+func findWebhookIdByName(ctx context.Context, client *zendesk_webhook_api.WebhookApi, name string) (string, error) {
+	params := &zendesk_webhook_api.ListWebhooksParams{FilterNameContains: &name}
+	var matchingIds []string
+	err := client.ForEachWebhook(ctx, params, func(webhook zendesk_webhook_api.WebhookWithoutSensitive) bool {
+		if webhook.Name != nil && *webhook.Name == name && webhook.Id != nil {
+			matchingIds = append(matchingIds, *webhook.Id)
+		}
+		return true
+	})
+	if err != nil {
+		return "", err
+	}
+
+	switch len(matchingIds) {
+	case 0:
+		return "", fmt.Errorf("no webhook is named %q", name)
+	case 1:
+		return matchingIds[0], nil
+	default:
+		return "", fmt.Errorf("%d webhooks are named %q, use the id of one of them instead: %s", len(matchingIds), name, strings.Join(matchingIds, ", "))
+	}
+}
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	"terraform-provider-zendesk/zendesk_http"
+	"terraform-provider-zendesk/zendesk_webhook_api"
+)
+
+func TestFindWebhookIdByName(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("page[after]") == "" {
+			_, _ = w.Write([]byte(`{"webhooks":[{"id":"1","name":"hook"},{"id":"2","name":"hook copy"},{"id":"3","name":"twin"}],
+				"meta":{"has_more":true,"after_cursor":"c1"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"webhooks":[{"id":"4","name":"Hook"},{"id":"5","name":"twin"}],
+			"meta":{"has_more":false,"after_cursor":"c2"}}`))
+	}))
+	defer server.Close()
+
+	client, err := zendesk_webhook_api.NewWebhookApi(server.URL, zendesk_http.NewBearerTokenAuthenticator("t"), nil)
+	assert.NilError(t, err)
+
+	tests := []struct {
+		name      string
+		webhook   string
+		wantId    string
+		wantError string
+	}{
+		{name: "exact match on the first page", webhook: "hook", wantId: "1"},
+		{name: "exact match on a later page", webhook: "Hook", wantId: "4"},
+		{name: "no match", webhook: "hoo", wantError: `no webhook is named "hoo"`},
+		{name: "several matches", webhook: "twin", wantError: `2 webhooks are named "twin", use the id of one of them instead: 3, 5`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := findWebhookIdByName(context.Background(), client, tt.webhook)
+			if tt.wantError != "" {
+				assert.Error(t, err, tt.wantError)
+				return
+			}
+			assert.NilError(t, err)
+			assert.Equal(t, id, tt.wantId)
+		})
+	}
+}
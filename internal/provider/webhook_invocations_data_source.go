@@ -0,0 +1,321 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strconv"
+	"terraform-provider-zendesk/zendesk_webhook_api"
+	"time"
+)
+
+var (
+	_ datasource.DataSource              = (*webhookInvocationsDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*webhookInvocationsDataSource)(nil)
+)
+
+// newestInvocationsFirst sorts the invocations by the completion of their latest attempt, descending.
+const newestInvocationsFirst = "-latest_completed_at"
+
+func NewWebhookInvocationsDataSource() datasource.DataSource {
+	return &webhookInvocationsDataSource{}
+}
+
+type webhookInvocationsDataSource struct {
+	client *zendesk_webhook_api.WebhookApi
+}
+
+type webhookInvocationsDataSourceModel struct {
+	WebhookId   types.String             `tfsdk:"webhook_id"`
+	Status      types.String             `tfsdk:"status"`
+	FromTs      types.String             `tfsdk:"from_ts"`
+	ToTs        types.String             `tfsdk:"to_ts"`
+	PageSize    types.Int64              `tfsdk:"page_size"`
+	Invocations []webhookInvocationModel `tfsdk:"invocations"`
+}
+
+type webhookInvocationModel struct {
+	Id                types.String                    `tfsdk:"id"`
+	Status            types.String                    `tfsdk:"status"`
+	StatusCode        types.Int64                     `tfsdk:"status_code"`
+	CreatedAt         types.String                    `tfsdk:"created_at"`
+	LatestCompletedAt types.String                    `tfsdk:"latest_completed_at"`
+	LatencyMs         types.Int64                     `tfsdk:"latency_ms"`
+	Attempts          []webhookInvocationAttemptModel `tfsdk:"attempts"`
+}
+
+type webhookInvocationAttemptModel struct {
+	Id              types.String `tfsdk:"id"`
+	Status          types.String `tfsdk:"status"`
+	StatusCode      types.Int64  `tfsdk:"status_code"`
+	CompletedAt     types.String `tfsdk:"completed_at"`
+	LatencyMs       types.Int64  `tfsdk:"latency_ms"`
+	ResponsePayload types.String `tfsdk:"response_payload"`
+}
+
+func (d *webhookInvocationsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook_invocations"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *webhookInvocationsDataSource) Configure(ctx context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := request.ProviderData.(zendeskProviderData)
+
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *zendeskProviderData, got: %T. Please report this issue to the provider developers.",
+				request.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.webhookApi
+}
+
+func (d *webhookInvocationsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the latest invocations of a webhook with their attempts and latencies, e.g. to check in a `check` block " +
+			"that the deliveries succeed in time. Zendesk only reports when an invocation was created and when its attempts completed, " +
+			"the latencies are measured between these times.",
+		Attributes: map[string]schema.Attribute{
+			"webhook_id": schema.StringAttribute{
+				Description: "Id of the webhook.",
+				Required:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Only read invocations with this status, e.g. `success` or `failed`.",
+				Optional:    true,
+			},
+			"from_ts": schema.StringAttribute{
+				Description: "Only read invocations since this RFC 3339 timestamp.",
+				Optional:    true,
+			},
+			"to_ts": schema.StringAttribute{
+				Description: "Only read invocations until this RFC 3339 timestamp.",
+				Optional:    true,
+			},
+			"page_size": schema.Int64Attribute{
+				Description: "Number of invocations to read, the newest first. Zendesk reads 20 when not set.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+			},
+			"invocations": schema.ListNestedAttribute{
+				Description: "Invocations of the webhook, the newest first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Id of the invocation.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Status of the invocation, the status of its latest attempt.",
+							Computed:    true,
+						},
+						"status_code": schema.Int64Attribute{
+							Description: "HTTP status the endpoint answered the latest attempt with.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "When the invocation was created.",
+							Computed:    true,
+						},
+						"latest_completed_at": schema.StringAttribute{
+							Description: "When the latest attempt completed.",
+							Computed:    true,
+						},
+						"latency_ms": schema.Int64Attribute{
+							Description: "Milliseconds from the creation of the invocation until its latest attempt completed, " +
+								"including the waits before retries.",
+							Computed: true,
+						},
+						"attempts": schema.ListNestedAttribute{
+							Description: "Attempts to deliver the invocation.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Description: "Id of the attempt.",
+										Computed:    true,
+									},
+									"status": schema.StringAttribute{
+										Description: "Status of the attempt.",
+										Computed:    true,
+									},
+									"status_code": schema.Int64Attribute{
+										Description: "HTTP status the endpoint answered with.",
+										Computed:    true,
+									},
+									"completed_at": schema.StringAttribute{
+										Description: "When the attempt completed.",
+										Computed:    true,
+									},
+									"latency_ms": schema.Int64Attribute{
+										Description: "Milliseconds from the creation of the invocation until the attempt completed. " +
+											"For the first attempt this is the delivery latency, for a retry it includes the wait before it.",
+										Computed: true,
+									},
+									"response_payload": schema.StringAttribute{
+										Description: "Body the endpoint answered with.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *webhookInvocationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config webhookInvocationsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for name, timestamp := range map[string]types.String{"from_ts": config.FromTs, "to_ts": config.ToTs} {
+		if timestamp.IsNull() || timestamp.IsUnknown() {
+			continue
+		}
+		if _, err := time.Parse(time.RFC3339, timestamp.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(name), "Invalid Timestamp",
+				fmt.Sprintf("%s must be an RFC 3339 timestamp like 2024-01-31T08:00:00Z: %s", name, err))
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sort := newestInvocationsFirst
+	params := &zendesk_webhook_api.ListWebhookInvocationsParams{
+		Sort:         &sort,
+		FilterStatus: config.Status.ValueStringPointer(),
+		FilterFromTs: config.FromTs.ValueStringPointer(),
+		FilterToTs:   config.ToTs.ValueStringPointer(),
+	}
+	if !config.PageSize.IsNull() {
+		pageSize := strconv.FormatInt(config.PageSize.ValueInt64(), 10)
+		params.PageSize = &pageSize
+	}
+
+	invocations, err := listWebhookInvocations(ctx, d.client, config.WebhookId.ValueString(), params)
+	if err != nil {
+		tflog.Error(ctx, "Error reading webhook invocations from the API: ", map[string]interface{}{"error": err})
+		resp.Diagnostics.AddError("Error reading webhook invocations from the API", err.Error())
+		return
+	}
+	config.Invocations = invocations
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// listWebhookInvocations reads one page of invocations and the attempts of each of them.
+func listWebhookInvocations(ctx context.Context, client *zendesk_webhook_api.WebhookApi, webhookId string, params *zendesk_webhook_api.ListWebhookInvocationsParams) ([]webhookInvocationModel, error) {
+	tflog.Debug(ctx, "Read webhook invocations", map[string]any{"webhook_id": webhookId})
+	reqEditors := make([]zendesk_webhook_api.RequestEditorFn, 0)
+	response, err := client.GetClient().ListWebhookInvocationsWithResponse(ctx, webhookId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode() != 200 || response.JSON200 == nil {
+		return nil, fmt.Errorf("listing the invocations of webhook %v failed with the status %v: %s",
+			webhookId, response.StatusCode(), string(response.Body))
+	}
+
+	invocations := make([]webhookInvocationModel, 0)
+	if response.JSON200.Invocations == nil {
+		return invocations, nil
+	}
+	for _, invocation := range *response.JSON200.Invocations {
+		if invocation.Id == nil {
+			continue
+		}
+		attempts, err := listWebhookInvocationAttempts(ctx, client, webhookId, *invocation.Id, invocation.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		invocations = append(invocations, webhookInvocationModel{
+			Id:                types.StringPointerValue(invocation.Id),
+			Status:            types.StringPointerValue(invocation.Status),
+			StatusCode:        int64PointerValue(invocation.StatusCode),
+			CreatedAt:         types.StringPointerValue(invocation.CreatedAt),
+			LatestCompletedAt: types.StringPointerValue(invocation.LatestCompletedAt),
+			LatencyMs:         latencyMs(invocation.CreatedAt, invocation.LatestCompletedAt),
+			Attempts:          attempts,
+		})
+	}
+	return invocations, nil
+}
+
+// listWebhookInvocationAttempts reads the attempts of an invocation, their latencies are measured from invocationCreatedAt.
+func listWebhookInvocationAttempts(ctx context.Context, client *zendesk_webhook_api.WebhookApi, webhookId string, invocationId string, invocationCreatedAt *string) ([]webhookInvocationAttemptModel, error) {
+	reqEditors := make([]zendesk_webhook_api.RequestEditorFn, 0)
+	response, err := client.GetClient().ListWebhookInvocationAttemptsWithResponse(ctx, webhookId, invocationId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode() != 200 || response.JSON200 == nil {
+		return nil, fmt.Errorf("listing the attempts of webhook invocation %v failed with the status %v: %s",
+			invocationId, response.StatusCode(), string(response.Body))
+	}
+
+	attempts := make([]webhookInvocationAttemptModel, 0)
+	if response.JSON200.Attempts == nil {
+		return attempts, nil
+	}
+	for _, attempt := range *response.JSON200.Attempts {
+		responsePayload := types.StringNull()
+		if attempt.Response != nil {
+			responsePayload = types.StringPointerValue(attempt.Response.Payload)
+		}
+		attempts = append(attempts, webhookInvocationAttemptModel{
+			Id:              types.StringPointerValue(attempt.Id),
+			Status:          types.StringPointerValue(attempt.Status),
+			StatusCode:      int64PointerValue(attempt.StatusCode),
+			CompletedAt:     types.StringPointerValue(attempt.CompletedAt),
+			LatencyMs:       latencyMs(invocationCreatedAt, attempt.CompletedAt),
+			ResponsePayload: responsePayload,
+		})
+	}
+	return attempts, nil
+}
+
+// latencyMs returns the milliseconds between two RFC 3339 timestamps, null when one of them is missing or invalid.
+func latencyMs(from *string, to *string) types.Int64 {
+	if from == nil || to == nil {
+		return types.Int64Null()
+	}
+	fromTime, err := time.Parse(time.RFC3339, *from)
+	if err != nil {
+		return types.Int64Null()
+	}
+	toTime, err := time.Parse(time.RFC3339, *to)
+	if err != nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(toTime.Sub(fromTime).Milliseconds())
+}
+
+func int64PointerValue(value *int) types.Int64 {
+	if value == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*value))
+}
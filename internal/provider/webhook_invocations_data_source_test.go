@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gotest.tools/v3/assert"
+	"terraform-provider-zendesk/zendesk_http"
+	"terraform-provider-zendesk/zendesk_webhook_api"
+)
+
+func TestListWebhookInvocations(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v2/webhooks/01ABC/invocations":
+			assert.Equal(t, r.URL.Query().Get("filter[status]"), "failed")
+			assert.Equal(t, r.URL.Query().Get("page[size]"), "2")
+			assert.Equal(t, r.URL.Query().Get("sort"), "-latest_completed_at")
+			_, _ = w.Write([]byte(`{"invocations":[{"id":"i1","status":"failed","status_code":502,"created_at":"2024-01-31T07:58:59.250Z","latest_completed_at":"2024-01-31T08:00:00Z"}],
+				"meta":{"has_more":false}}`))
+		case "/api/v2/webhooks/01ABC/invocations/i1/attempts":
+			_, _ = w.Write([]byte(`{"attempts":[
+				{"id":"a1","invocation_id":"i1","status":"failed","status_code":500,"completed_at":"2024-01-31T07:59:00Z","response":{"payload":"oops"}},
+				{"id":"a2","invocation_id":"i1","status":"failed","status_code":502,"completed_at":"2024-01-31T08:00:00Z"}]}`))
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := zendesk_webhook_api.NewWebhookApi(server.URL, zendesk_http.NewBearerTokenAuthenticator("t"), nil)
+	assert.NilError(t, err)
+
+	status, pageSize, sort := "failed", "2", newestInvocationsFirst
+	invocations, err := listWebhookInvocations(context.Background(), client, "01ABC",
+		&zendesk_webhook_api.ListWebhookInvocationsParams{FilterStatus: &status, PageSize: &pageSize, Sort: &sort})
+	assert.NilError(t, err)
+
+	assert.DeepEqual(t, invocations, []webhookInvocationModel{{
+		Id:                types.StringValue("i1"),
+		Status:            types.StringValue("failed"),
+		StatusCode:        types.Int64Value(502),
+		CreatedAt:         types.StringValue("2024-01-31T07:58:59.250Z"),
+		LatestCompletedAt: types.StringValue("2024-01-31T08:00:00Z"),
+		LatencyMs:         types.Int64Value(60750),
+		Attempts: []webhookInvocationAttemptModel{
+			{Id: types.StringValue("a1"), Status: types.StringValue("failed"), StatusCode: types.Int64Value(500),
+				CompletedAt: types.StringValue("2024-01-31T07:59:00Z"), LatencyMs: types.Int64Value(750), ResponsePayload: types.StringValue("oops")},
+			{Id: types.StringValue("a2"), Status: types.StringValue("failed"), StatusCode: types.Int64Value(502),
+				CompletedAt: types.StringValue("2024-01-31T08:00:00Z"), LatencyMs: types.Int64Value(60750), ResponsePayload: types.StringNull()},
+		},
+	}})
+}
+
+func TestListWebhookInvocationsFails(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"errors":[{"code":"WebhookNotFound"}]}`))
+	}))
+	defer server.Close()
+
+	client, err := zendesk_webhook_api.NewWebhookApi(server.URL, zendesk_http.NewBearerTokenAuthenticator("t"), nil)
+	assert.NilError(t, err)
+
+	_, err = listWebhookInvocations(context.Background(), client, "01ABC", &zendesk_webhook_api.ListWebhookInvocationsParams{})
+	assert.ErrorContains(t, err, "listing the invocations of webhook 01ABC failed with the status 404")
+}
+
+func TestLatencyMs(t *testing.T) {
+	from, to, invalid := "2024-01-31T07:59:59Z", "2024-01-31T08:00:00.125Z", "yesterday"
+	assert.Equal(t, latencyMs(&from, &to), types.Int64Value(1125))
+	assert.Equal(t, latencyMs(nil, &to), types.Int64Null())
+	assert.Equal(t, latencyMs(&invalid, &to), types.Int64Null())
+}
@@ -1,19 +1,34 @@
 package provider
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"strconv"
+	"strings"
 	"terraform-provider-zendesk/internal/resource_webhook"
+	"terraform-provider-zendesk/zendesk_http"
 	"terraform-provider-zendesk/zendesk_webhook_api"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
-var _ resource.Resource = (*webhookResource)(nil)
+var (
+	_ resource.Resource               = (*webhookResource)(nil)
+	_ resource.ResourceWithModifyPlan = (*webhookResource)(nil)
+)
+
+// mergePatchContentType is the content type Zendesk expects for patching a webhook.
+const mergePatchContentType = "application/merge-patch+json"
 
 func NewWebhookResource() resource.Resource {
 	return &webhookResource{}
@@ -23,6 +38,36 @@ type webhookResource struct {
 	client *zendesk_webhook_api.WebhookApi
 }
 
+// webhookResourceModel is the generated resource_webhook.WebhookModel with clone_from_id and the verify_on_apply
+// block, which are handled by the provider and never stored in the webhook.
+type webhookResourceModel struct {
+	Webhook       resource_webhook.WebhookValue `tfsdk:"webhook"`
+	WebhookId     types.String                  `tfsdk:"webhook_id"`
+	CloneFromId   types.String                  `tfsdk:"clone_from_id"`
+	VerifyOnApply types.Object                  `tfsdk:"verify_on_apply"`
+}
+
+type webhookVerifyOnApplyModel struct {
+	Payload types.String `tfsdk:"payload"`
+}
+
+func (m *webhookResourceModel) webhookModel() *resource_webhook.WebhookModel {
+	return &resource_webhook.WebhookModel{Webhook: m.Webhook, WebhookId: m.WebhookId}
+}
+
+func (m *webhookResourceModel) setWebhookModel(webhookModel *resource_webhook.WebhookModel) {
+	m.Webhook = webhookModel.Webhook
+	m.WebhookId = webhookModel.WebhookId
+}
+
+// webhookAttributePaths maps the fields of validation errors of the Webhook API to the Terraform paths.
+var webhookAttributePaths = nestedAttributePaths("webhook", resource_webhook.WebhookValue{}.AttributeTypes(context.Background()))
+
+// inheritsAuthentication tells whether the authentication of the webhook was cloned and is not managed by Terraform.
+func (m *webhookResourceModel) inheritsAuthentication() bool {
+	return !m.CloneFromId.IsNull() && m.Webhook.Authentication.IsNull()
+}
+
 func (r *webhookResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_webhook"
 }
@@ -52,104 +97,233 @@ func (r *webhookResource) Configure(ctx context.Context, request resource.Config
 
 func (r *webhookResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = resource_webhook.WebhookResourceSchema(ctx)
+	resp.Schema.Attributes["clone_from_id"] = schema.StringAttribute{
+		Description: "Id of a webhook to create this webhook as a clone of. Without `authentication` in `webhook`, " +
+			"the webhook inherits the authentication of the cloned webhook, which then stays unmanaged and never gets into the state.",
+		Optional: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	resp.Schema.Blocks = map[string]schema.Block{
+		"verify_on_apply": schema.SingleNestedBlock{
+			Description: "When set, Zendesk sends a test request with the planned endpoint, headers and authentication " +
+				"when the webhook is created or they change, in the plan and again before the webhook is saved. " +
+				"The plan or apply fails when the endpoint does not answer with a 2xx status, and the webhook is not saved.",
+			Attributes: map[string]schema.Attribute{
+				"payload": schema.StringAttribute{
+					Description: "Body of the test request. Zendesk sends a sample payload when not set.",
+					Optional:    true,
+				},
+			},
+		},
+	}
 }
 
-// ImportState imports a Webhook by a given id, when the id value is an integer, or by name=id otherwise /*
-func (r *webhookResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	tflog.Debug(ctx, "Called ImportState of a webhook resource")
-	id := request.ID
-	tflog.Debug(ctx, "request.id: "+id)
+// ModifyPlan tests the planned endpoint when verify_on_apply is set, so a wrong endpoint or authentication fails the
+// plan. Settings only known in the apply are tested there, before the webhook is saved.
+func (r *webhookResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
 
-	webhookIdPath1 := path.Root("webhook_id")
-	webhookIdPath2 := path.Root("webhook").AtName("id")
-	var webhookIdOfMatching string
+	var plan webhookResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state *webhookResourceModel
+	if !req.State.Raw.IsNull() {
+		state = &webhookResourceModel{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
-	reqEditors := make([]zendesk_webhook_api.RequestEditorFn, 0)
-	webhookShowResponse, err := r.client.GetClient().ShowWebhookWithResponse(ctx, id, reqEditors...)
-	if err != nil {
-		tflog.Error(ctx, "Error reading webhook data from the API: ", map[string]interface{}{"error": err})
-		response.Diagnostics.AddError("Error reading webhook data from the API", err.Error())
+	if !plan.endpointTestDue(state) || !plan.endpointSettingsKnown(ctx) {
 		return
 	}
+	resp.Diagnostics.Append(r.verifyEndpoint(ctx, &plan, state)...)
+}
 
-	if webhookShowResponse.StatusCode() == 404 {
+// endpointTestDue tells whether verify_on_apply is set and the webhook is created or its endpoint settings or the
+// test payload change.
+func (m *webhookResourceModel) endpointTestDue(state *webhookResourceModel) bool {
+	if m.VerifyOnApply.IsNull() {
+		return false
+	}
+	if state == nil {
+		return true
+	}
+	return !m.VerifyOnApply.Equal(state.VerifyOnApply) ||
+		!m.Webhook.Endpoint.Equal(state.Webhook.Endpoint) ||
+		!m.Webhook.HttpMethod.Equal(state.Webhook.HttpMethod) ||
+		!m.Webhook.RequestFormat.Equal(state.Webhook.RequestFormat) ||
+		!m.Webhook.CustomHeaders.Equal(state.Webhook.CustomHeaders) ||
+		!m.Webhook.Authentication.Equal(state.Webhook.Authentication)
+}
 
-		reqEditors := make([]zendesk_webhook_api.RequestEditorFn, 0)
-		webhooks, err := r.client.GetClient().ListWebhooksWithResponse(ctx, nil, reqEditors...)
-		if err != nil {
-			tflog.Error(ctx, "Error reading webhooks list from the API: ", map[string]interface{}{"error": err})
-			response.Diagnostics.AddError("Error reading webhooks list from the API", err.Error())
-			return
+// endpointSettingsKnown tells whether the settings sent in the test request are known.
+func (m *webhookResourceModel) endpointSettingsKnown(ctx context.Context) bool {
+	values := []attr.Value{m.VerifyOnApply, m.Webhook.Endpoint, m.Webhook.HttpMethod, m.Webhook.RequestFormat,
+		m.Webhook.CustomHeaders, m.Webhook.Authentication}
+	for _, value := range values {
+		terraformValue, err := value.ToTerraformValue(ctx)
+		if err != nil || !terraformValue.IsFullyKnown() {
+			return false
 		}
+	}
+	return true
+}
 
-		if webhooks.StatusCode() != 200 {
-			tflog.Error(ctx, "Error reading webhooks list from the API: ", map[string]interface{}{"error": string(webhooks.Body)})
-			response.Diagnostics.AddError("Error reading webhooks list from the API", fmt.Sprintf("%+v", string(webhooks.Body)))
-			return
-		}
+// verifyEndpoint lets Zendesk send a test request with the planned endpoint, headers and authentication of the
+// webhook. An existing webhook is tested by its id, which adds its signing secret, and a clone that inherits the
+// authentication by the id of the cloned webhook.
+func (r *webhookResource) verifyEndpoint(ctx context.Context, plan *webhookResourceModel, state *webhookResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var verifyOnApply webhookVerifyOnApplyModel
+	diags.Append(plan.VerifyOnApply.As(ctx, &verifyOnApply, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return diags
+	}
+
+	requestBody, mapDiags := resource_webhook.NewWebhookMapper().MapToCreateRequestBody(ctx, plan.webhookModel())
+	diags.Append(mapDiags...)
+	if diags.HasError() {
+		return diags
+	}
+	settings := webhookTestRequestSettings{
+		Endpoint:       &requestBody.Webhook.Endpoint,
+		HttpMethod:     &requestBody.Webhook.HttpMethod,
+		RequestFormat:  &requestBody.Webhook.RequestFormat,
+		Authentication: requestBody.Webhook.Authentication,
+		Payload:        verifyOnApply.Payload.ValueStringPointer(),
+	}
+	if requestBody.Webhook.CustomHeaders != nil {
+		settings.CustomHeaders = *requestBody.Webhook.CustomHeaders
+	}
 
-		list := webhooks.JSON200.Webhooks
+	var webhookId *string
+	if state != nil {
+		webhookId = state.WebhookId.ValueStringPointer()
+	} else if plan.inheritsAuthentication() {
+		webhookId = plan.CloneFromId.ValueStringPointer()
+	}
 
-		for _, webhook := range *list {
-			if webhook.Name == &id {
-				webhookIdOfMatching = *webhook.Id
-				break
-			}
-		}
+	testResponse, err := testWebhook(ctx, r.client, webhookId, settings)
+	if err != nil {
+		tflog.Error(ctx, "Error testing the webhook: ", map[string]interface{}{"error": err})
+		diags.AddAttributeError(path.Root("verify_on_apply"), "Error testing the webhook", err.Error())
+		return diags
+	}
+	if !testResponse.isSuccess() {
+		diags.AddAttributeError(
+			path.Root("verify_on_apply"),
+			"Webhook Endpoint Test Failed",
+			fmt.Sprintf("The endpoint %s answered the test request with the status %d: %s. "+
+				"The webhook was not saved, fix the endpoint or its authentication and apply again.",
+				plan.Webhook.Endpoint.ValueString(), testResponse.Status, testResponse.Payload),
+		)
+	}
+	return diags
+}
+
+// ImportState imports a webhook by its id or its name. The import id `id:<id>` or `name:<name>` selects how the
+// webhook is looked up, an import id without prefix is tried as id first and as name then.
+func (r *webhookResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Called ImportState of a webhook resource", map[string]any{"import_id": request.ID})
 
-	} else if webhookShowResponse.StatusCode() != 200 {
-		tflog.Error(ctx, "Error reading webhook data from the API: ", map[string]interface{}{"error": string(webhookShowResponse.Body)})
-		response.Diagnostics.AddError("Error reading webhook data from the API", fmt.Sprintf("%+v", string(webhookShowResponse.Body)))
+	webhookId, err := resolveWebhookImportId(ctx, r.client, request.ID)
+	if err != nil {
+		tflog.Error(ctx, "Error importing webhook: ", map[string]interface{}{"error": err})
+		response.Diagnostics.AddError("Error importing webhook",
+			fmt.Sprintf("Cannot import the webhook %q: %s", request.ID, err.Error()))
 		return
-	} else {
-		webhookIdOfMatching = *webhookShowResponse.JSON200.Webhook.Id
 	}
 
-	response.Diagnostics.Append(response.State.SetAttribute(ctx, webhookIdPath1, webhookIdOfMatching)...)
-	response.Diagnostics.Append(response.State.SetAttribute(ctx, webhookIdPath2, webhookIdOfMatching)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("webhook_id"), webhookId)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("webhook").AtName("id"), webhookId)...)
 
 	if response.Diagnostics.HasError() {
-
 		tflog.Error(ctx, "Error importing state: ", map[string]any{"error": response.Diagnostics.Errors()})
 		return
 	}
 
-	tflog.Info(ctx, "ImportState custom status completed successfully")
+	tflog.Info(ctx, "ImportState webhook completed successfully", map[string]any{"webhook_id": webhookId})
+}
+
+// resolveWebhookImportId returns the id of the webhook an import id refers to.
+func resolveWebhookImportId(ctx context.Context, client *zendesk_webhook_api.WebhookApi, importId string) (string, error) {
+	if name, found := strings.CutPrefix(importId, "name:"); found {
+		return findWebhookIdByName(ctx, client, name)
+	}
+	id, explicitId := strings.CutPrefix(importId, "id:")
+
+	reqEditors := make([]zendesk_webhook_api.RequestEditorFn, 0)
+	webhookShowResponse, err := client.GetClient().ShowWebhookWithResponse(ctx, id, reqEditors...)
+	if err != nil {
+		return "", fmt.Errorf("reading webhook from the API: %w", err)
+	}
+
+	switch {
+	case webhookShowResponse.StatusCode() == 200 && webhookShowResponse.JSON200 != nil && webhookShowResponse.JSON200.Webhook != nil:
+		return *webhookShowResponse.JSON200.Webhook.Id, nil
+	case webhookShowResponse.StatusCode() == 404 && explicitId:
+		return "", fmt.Errorf("no webhook has the id %q", id)
+	case webhookShowResponse.StatusCode() == 404:
+		return findWebhookIdByName(ctx, client, id)
+	default:
+		return "", fmt.Errorf("reading webhook from the API, StatusCode: %v: %s", webhookShowResponse.StatusCode(), string(webhookShowResponse.Body))
+	}
 }
 
 func (r *webhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var planModel resource_webhook.WebhookModel
+	var plan webhookResourceModel
 
 	// Read Terraform plan data into the model
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &planModel)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "Error reading Terraform plan data into the model: ", map[string]any{"error": resp.Diagnostics.Errors()})
 		return
 	}
+	planModel := plan.webhookModel()
 
-	tflog.Debug(ctx, "Create webhook resource with model: "+fmt.Sprintf("%+v", &planModel))
+	// the model holds the webhook authentication data, request and response are logged redacted by the API client
+	tflog.Debug(ctx, "Create webhook resource", map[string]any{"name": planModel.Webhook.Name.ValueString()})
 
 	webhookMapper := resource_webhook.NewWebhookMapper()
-	requestBody, diags := webhookMapper.MapToCreateRequestBody(ctx, &planModel)
+	requestBody, diags := webhookMapper.MapToCreateRequestBody(ctx, planModel)
 	if diags != nil && diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 		return
 	}
-	tflog.Debug(ctx, "Create webhook resource with request body: "+fmt.Sprintf("%+v", requestBody))
+	if plan.endpointTestDue(nil) {
+		resp.Diagnostics.Append(r.verifyEndpoint(ctx, &plan, nil)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var params *zendesk_webhook_api.CreateOrCloneWebhookParams
+	if !plan.CloneFromId.IsNull() {
+		tflog.Debug(ctx, "Clone webhook", map[string]any{"clone_webhook_id": plan.CloneFromId.ValueString()})
+		params = &zendesk_webhook_api.CreateOrCloneWebhookParams{CloneWebhookId: plan.CloneFromId.ValueStringPointer()}
+	}
 	reqEditors := make([]zendesk_webhook_api.RequestEditorFn, 0)
-	createResponse, err := r.client.GetClient().CreateOrCloneWebhookWithResponse(ctx, nil, *requestBody, reqEditors...)
+	createResponse, err := r.client.GetClient().CreateOrCloneWebhookWithResponse(ctx, params, *requestBody, reqEditors...)
 	if err != nil {
 		tflog.Error(ctx, "Error creating webhook data from the API: ", map[string]interface{}{"error": err})
 		resp.Diagnostics.AddError("Error creating webhook data from the API", err.Error())
 		return
 	}
-	tflog.Debug(ctx, fmt.Sprintf("Received API response: Status %v, Body: %+v", createResponse.StatusCode(), string(createResponse.Body)))
+	tflog.Debug(ctx, fmt.Sprintf("Received API response: Status %v", createResponse.StatusCode()))
 	if createResponse.StatusCode() != 201 {
 		tflog.Error(ctx, "Error creating webhook data from the API: ", map[string]interface{}{"error": string(createResponse.Body)})
 		body, _ := json.Marshal(requestBody)
-		resp.Diagnostics.AddError("Error creating webhook data from the API", fmt.Sprintf("Code: %v Body: %+v Request: %v", createResponse.StatusCode(), string(createResponse.Body), string(body)))
+		tflog.Debug(ctx, "Rejected webhook create request", map[string]interface{}{"request": zendesk_http.RedactBody(body)})
+		addAPIError(&resp.Diagnostics, "Error creating webhook data from the API", createResponse.HTTPResponse, createResponse.Body, webhookAttributePaths)
 		return
 	}
 	if createResponse.JSON201.Webhook.SigningSecret == nil {
@@ -166,15 +340,31 @@ func (r *webhookResource) Create(ctx context.Context, req resource.CreateRequest
 	id := *createResponse.JSON201.Webhook.Id
 	fetchSigningSecret(ctx, r.client, id, signingSecret)
 
-	diags2 := webhookMapper.UpdateAttributesWithCreateResponse(ctx, createResponse, &planModel)
+	diags2 := webhookMapper.UpdateAttributesWithCreateResponse(ctx, createResponse, planModel)
 	if diags2 != nil && diags2.HasError() {
 		tflog.Error(ctx, "Error updating webhook model with create response: ", map[string]any{"error": diags2.Errors()})
 		resp.Diagnostics.Append(diags2...)
 		return
 	}
 
+	plan.setWebhookModel(planModel)
+
+	if !plan.CloneFromId.IsNull() {
+		// The clone has the settings of the cloned webhook, patch it to the planned ones. It is saved first,
+		// so a failed patch taints the resource instead of leaving the clone untracked in Zendesk.
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(r.patchClone(ctx, id, planModel)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		plan.setWebhookModel(planModel)
+	}
+
 	// Save data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &planModel)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func fetchSigningSecret(ctx context.Context, client *zendesk_webhook_api.WebhookApi, id string, signingSecret *struct {
@@ -192,10 +382,11 @@ func fetchSigningSecret(ctx context.Context, client *zendesk_webhook_api.Webhook
 }
 
 func (r *webhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state resource_webhook.WebhookModel
+	var stateWithVerification webhookResourceModel
 
 	// Read Terraform prior state data into the model
-	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateWithVerification)...)
+	state := stateWithVerification.webhookModel()
 
 	if resp.Diagnostics.HasError() {
 		logErrors(ctx, resp)
@@ -215,11 +406,15 @@ func (r *webhookResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	if removeResourceWhenNotFound(ctx, webhookResponse.StatusCode(), "zendesk_webhook", webhookId, resp) {
+		return
+	}
+
 	if webhookResponse.StatusCode() != 200 {
 		message := fmt.Sprintf("Error reading webhook data from the API, StatusCode: %v , Request Param: %v", webhookResponse.StatusCode(), webhookId)
 		tflog.Error(ctx, message,
 			map[string]interface{}{"error": string(webhookResponse.Body)})
-		resp.Diagnostics.AddError("Error reading webhook data from the API", fmt.Sprintf("%+v", string(webhookResponse.Body)))
+		addAPIError(&resp.Diagnostics, "Error reading webhook data from the API", webhookResponse.HTTPResponse, webhookResponse.Body, nil)
 		return
 	}
 
@@ -235,10 +430,15 @@ func (r *webhookResource) Read(ctx context.Context, req resource.ReadRequest, re
 	fetchSigningSecret(ctx, r.client, webhookId, webhookResponse.JSON200.Webhook.SigningSecret)
 	webhookMapper := resource_webhook.NewWebhookMapper()
 
-	webhookMapper.PutWebhookShowResponseToStateModel(ctx, webhookResponse, &state)
+	inheritsAuthentication := stateWithVerification.inheritsAuthentication()
+	webhookMapper.PutWebhookShowResponseToStateModel(ctx, webhookResponse, state)
+	if inheritsAuthentication {
+		state.Webhook.Authentication = types.ObjectNull(resource_webhook.AuthenticationValue{}.AttributeTypes(ctx))
+	}
+	stateWithVerification.setWebhookModel(state)
 
 	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &stateWithVerification)...)
 }
 
 func logErrors(ctx context.Context, resp *resource.ReadResponse) {
@@ -246,69 +446,54 @@ func logErrors(ctx context.Context, resp *resource.ReadResponse) {
 }
 
 func (r *webhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var planModel resource_webhook.WebhookModel
+	var plan webhookResourceModel
 
 	tflog.Debug(ctx, "Update webhook resource")
 	// Read Terraform plan data into the model
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &planModel)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	planModel := plan.webhookModel()
 
-	tflog.Debug(ctx, fmt.Sprintf("Update webhook resource with model: %+v", &planModel))
+	tflog.Debug(ctx, "Update webhook resource", map[string]any{"name": planModel.Webhook.Name.ValueString()})
 
 	// Read Terraform state data into the model
-	var stateModel resource_webhook.WebhookModel
+	var stateModel webhookResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &stateModel)...)
 
-	tflog.Debug(ctx, fmt.Sprintf("Update webhook resource with previous state: %+v", &stateModel))
+	tflog.Debug(ctx, "Update webhook resource with previous state", map[string]any{"webhook_id": stateModel.WebhookId.ValueString()})
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if plan.endpointTestDue(&stateModel) {
+		resp.Diagnostics.Append(r.verifyEndpoint(ctx, &plan, &stateModel)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	webhookMapper := resource_webhook.NewWebhookMapper()
 
-	mappedRequestBody, diags := webhookMapper.MapToUpdateRequestBody(ctx, &planModel)
+	patchRequestBody, diags := webhookMapper.MapToPatchRequestBody(ctx, stateModel.webhookModel(), planModel)
 
 	if diags != nil && diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 		return
 	}
-	tflog.Debug(ctx, fmt.Sprintf("Update webhook resource with mapped request body: %+v", &mappedRequestBody))
 
-	reqEditors := make([]zendesk_webhook_api.RequestEditorFn, 0)
-	tflog.Debug(ctx, fmt.Sprintf("Will update webhook with id %v with request body: %+v and reqEditors %v", stateModel.WebhookId.ValueString(), &mappedRequestBody, &reqEditors))
-	response, err := r.client.GetClient().UpdateWebhookWithResponse(ctx, stateModel.WebhookId.ValueString(), mappedRequestBody, reqEditors...)
-
-	if err != nil {
-		resp.Diagnostics.AddError("Error updating webhook data from the API", err.Error())
-		return
-	}
-
-	if response.StatusCode() == 404 {
-
-		resp.Diagnostics.AddError("Error updating webhook data", "Webhook not found: "+string(response.Body))
-		return
-
-	}
-
-	if response.StatusCode() == 400 {
-		responseErrors, err := json.Marshal(response.JSON400.Errors)
-		if err != nil {
-			resp.Diagnostics.AddError("Error updating webhook data from the API", "Bad Request: "+err.Error())
+	if webhookPatch := patchRequestBody["webhook"].(map[string]interface{}); len(webhookPatch) > 0 {
+		resp.Diagnostics.Append(r.patchWebhook(ctx, stateModel.WebhookId.ValueString(), patchRequestBody)...)
+		if resp.Diagnostics.HasError() {
 			return
 		}
-		resp.Diagnostics.AddError("Error updating webhook data from the API", "Bad Request: "+string(responseErrors))
-		return
-
-	}
-	if response.StatusCode() != 204 {
-		detail := "Unexpected response status code: " + strconv.Itoa(response.StatusCode()) + ", Response Body: " + fmt.Sprintf("%+v", string(response.Body))
-		resp.Diagnostics.AddError("Error updating webhook data from the API", detail)
-		return
+	} else {
+		tflog.Debug(ctx, fmt.Sprintf("Webhook with id %v is unchanged, skipping the patch", stateModel.WebhookId.ValueString()))
 	}
+
 	reqEditors2 := make([]zendesk_webhook_api.RequestEditorFn, 0)
 	tflog.Debug(ctx, fmt.Sprintf("Will get webhook with id %v with reqEditors %v", stateModel.WebhookId.ValueString(), reqEditors2))
 	showResponse, err := r.client.GetClient().ShowWebhookWithResponse(ctx, stateModel.WebhookId.ValueString(), reqEditors2...)
@@ -334,19 +519,78 @@ func (r *webhookResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 	fetchSigningSecret(ctx, r.client, stateModel.WebhookId.ValueString(), showResponse.JSON200.Webhook.SigningSecret)
 
-	diagnostics := webhookMapper.PutWebhookShowResponseAfterUpdateToStateModel(ctx, showResponse, &planModel)
+	diagnostics := webhookMapper.PutWebhookShowResponseAfterUpdateToStateModel(ctx, showResponse, planModel)
 	if diagnostics != nil && diagnostics.HasError() {
 		tflog.Error(ctx, "Error updating webhook model with show response after successful update: ", map[string]any{"error": diagnostics.Errors()})
 		resp.Diagnostics.Append(diagnostics...)
 		return
 	}
+	plan.setWebhookModel(planModel)
 
 	// Save updated data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &planModel)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// patchClone patches a cloned webhook to the planned settings and reads it back into the plan. An authentication
+// missing in the plan is left out of the patch, so the clone keeps the authentication of the cloned webhook.
+func (r *webhookResource) patchClone(ctx context.Context, webhookId string, planModel *resource_webhook.WebhookModel) diag.Diagnostics {
+	webhookMapper := resource_webhook.NewWebhookMapper()
+	patchRequestBody, diags := webhookMapper.MapToPatchRequestBody(ctx, &resource_webhook.WebhookModel{}, planModel)
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(r.patchWebhook(ctx, webhookId, patchRequestBody)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	reqEditors := make([]zendesk_webhook_api.RequestEditorFn, 0)
+	showResponse, err := r.client.GetClient().ShowWebhookWithResponse(ctx, webhookId, reqEditors...)
+	if err != nil {
+		diags.AddError("Error reading webhook data from the API after successful clone", err.Error())
+		return diags
+	}
+	if showResponse.StatusCode() != 200 {
+		diags.AddError(fmt.Sprintf("Error reading webhook data from the API after successful clone with status %v", showResponse.StatusCode()), fmt.Sprintf("%+v", string(showResponse.Body)))
+		return diags
+	}
+	showResponse.JSON200.Webhook.SigningSecret = &struct {
+		Algorithm *string `json:"algorithm,omitempty"`
+		Secret    *string `json:"secret,omitempty"`
+	}{}
+	fetchSigningSecret(ctx, r.client, webhookId, showResponse.JSON200.Webhook.SigningSecret)
+
+	diags.Append(webhookMapper.PutWebhookShowResponseAfterUpdateToStateModel(ctx, showResponse, planModel)...)
+	return diags
+}
+
+// patchWebhook sends the merge patch of the webhook, see WebhookMapper.MapToPatchRequestBody.
+func (r *webhookResource) patchWebhook(ctx context.Context, webhookId string, patchRequestBody map[string]interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	body, err := json.Marshal(patchRequestBody)
+	if err != nil {
+		diags.AddError("Error updating webhook data from the API", err.Error())
+		return diags
+	}
+
+	reqEditors := make([]zendesk_webhook_api.RequestEditorFn, 0)
+	tflog.Debug(ctx, fmt.Sprintf("Will patch webhook with id %v", webhookId))
+	response, err := r.client.GetClient().PatchWebhookWithBodyWithResponse(ctx, webhookId, mergePatchContentType, bytes.NewReader(body), reqEditors...)
+
+	if err != nil {
+		diags.AddError("Error updating webhook data from the API", err.Error())
+		return diags
+	}
+
+	if response.StatusCode() != 204 {
+		addAPIError(&diags, "Error updating webhook data from the API", response.HTTPResponse, response.Body, webhookAttributePaths)
+	}
+	return diags
 }
 
 func (r *webhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data resource_webhook.WebhookModel
+	var data webhookResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -361,25 +605,8 @@ func (r *webhookResource) Delete(ctx context.Context, req resource.DeleteRequest
 		resp.Diagnostics.AddError("Error deleting webhook data from the API", err.Error())
 		return
 	}
-	if response.StatusCode() == 404 {
-		resp.Diagnostics.AddError("Error deleting webhook data from the API", "Webhook not found")
-		return
-	}
-
-	if response.StatusCode() == 400 {
-		responseErrors, err := json.Marshal(response.JSON400.Errors)
-		if err != nil {
-			resp.Diagnostics.AddError("Error deleting webhook data from the API", "Bad Request: "+err.Error())
-			return
-		}
-		resp.Diagnostics.AddError("Error deleting webhook data from the API", "Bad Request: "+string(responseErrors))
-		return
-
-	}
-
 	if response.StatusCode() != 204 {
-		detail := "Unexpected response status code: " + strconv.Itoa(response.StatusCode()) + ", Response Body: " + fmt.Sprintf("%+v", string(response.Body))
-		resp.Diagnostics.AddError("Error deleting webhook data from the API", detail)
+		addAPIError(&resp.Diagnostics, "Error deleting webhook data from the API", response.HTTPResponse, response.Body, nil)
 		return
 	}
 
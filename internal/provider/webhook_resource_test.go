@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gotest.tools/v3/assert"
+	"terraform-provider-zendesk/internal/resource_webhook"
+	"terraform-provider-zendesk/zendesk_http"
+	"terraform-provider-zendesk/zendesk_webhook_api"
+)
+
+func TestResolveWebhookImportId(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/api/v2/webhooks/01ABC":
+			_, _ = w.Write([]byte(`{"webhook":{"id":"01ABC","name":"name:hook"}}`))
+		case r.URL.Path == "/api/v2/webhooks" && r.URL.Query().Get("page[after]") == "":
+			_, _ = w.Write([]byte(`{"webhooks":[{"id":"1","name":"hook"},{"id":"2","name":"twin"}],"meta":{"has_more":true,"after_cursor":"c1"}}`))
+		case r.URL.Path == "/api/v2/webhooks":
+			_, _ = w.Write([]byte(`{"webhooks":[{"id":"3","name":"twin"},{"id":"4","name":"01XYZ"}],"meta":{"has_more":false}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors":[{"code":"WebhookNotFound"}]}`))
+		}
+	}))
+	defer server.Close()
+
+	client, err := zendesk_webhook_api.NewWebhookApi(server.URL, zendesk_http.NewBearerTokenAuthenticator("t"), nil)
+	assert.NilError(t, err)
+
+	tests := []struct {
+		name      string
+		importId  string
+		wantId    string
+		wantError string
+	}{
+		{name: "id", importId: "01ABC", wantId: "01ABC"},
+		{name: "explicit id", importId: "id:01ABC", wantId: "01ABC"},
+		{name: "unknown explicit id", importId: "id:01XYZ", wantError: `no webhook has the id "01XYZ"`},
+		{name: "name without prefix", importId: "hook", wantId: "1"},
+		{name: "name on a later page", importId: "01XYZ", wantId: "4"},
+		{name: "explicit name", importId: "name:hook", wantId: "1"},
+		{name: "unknown name", importId: "name:missing", wantError: `no webhook is named "missing"`},
+		{name: "ambiguous name", importId: "twin", wantError: `2 webhooks are named "twin", use the id of one of them instead: 2, 3`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := resolveWebhookImportId(context.Background(), client, tt.importId)
+			if tt.wantError != "" {
+				assert.Error(t, err, tt.wantError)
+				return
+			}
+			assert.NilError(t, err)
+			assert.Equal(t, id, tt.wantId)
+		})
+	}
+}
+
+func TestWebhookEndpointTestDue(t *testing.T) {
+	planned := verifiedWebhookModel(t, "https://example.com/hook")
+
+	assert.Assert(t, planned.endpointTestDue(nil), "a created webhook is tested")
+	state := verifiedWebhookModel(t, "https://example.com/hook")
+	assert.Assert(t, !planned.endpointTestDue(&state), "an unchanged webhook is not tested")
+	state.Webhook.Name = types.StringValue("renamed")
+	assert.Assert(t, !planned.endpointTestDue(&state), "a new name does not change the test request")
+	state = verifiedWebhookModel(t, "https://example.com/old")
+	assert.Assert(t, planned.endpointTestDue(&state), "a new endpoint is tested")
+
+	planned.VerifyOnApply = types.ObjectNull(planned.VerifyOnApply.AttributeTypes(context.Background()))
+	assert.Assert(t, !planned.endpointTestDue(nil), "webhooks without verify_on_apply are never tested")
+}
+
+func TestVerifyEndpoint(t *testing.T) {
+	tests := []struct {
+		name          string
+		state         *webhookResourceModel
+		status        int
+		wantWebhookId string
+		wantError     string
+	}{
+		{name: "new webhook", status: 200},
+		{name: "changed webhook", state: &webhookResourceModel{WebhookId: types.StringValue("01ABC")}, status: 204, wantWebhookId: "01ABC"},
+		{name: "wrong authentication", status: 401, wantError: "The endpoint https://example.com/hook answered the test request with the status 401: unauthorized. " +
+			"The webhook was not saved, fix the endpoint or its authentication and apply again."},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, r.URL.Path, "/api/v2/webhooks/test")
+				assert.Equal(t, r.URL.Query().Get("webhook_id"), tt.wantWebhookId)
+				body, _ := io.ReadAll(r.Body)
+				var request webhookTestRequest
+				assert.NilError(t, json.Unmarshal(body, &request))
+				assert.Equal(t, *request.Request.Endpoint, "https://example.com/hook")
+				assert.Equal(t, *request.Request.Payload, `{"ping":true}`)
+				assert.Equal(t, request.Request.Authentication.Type, "bearer_token")
+				assert.Equal(t, *request.Request.Authentication.Data.Token, "planned-token")
+
+				w.Header().Set("Content-Type", "application/json")
+				payload, _ := json.Marshal(map[string]any{"response": map[string]any{"status": tt.status, "payload": "unauthorized"}})
+				_, _ = w.Write(payload)
+			}))
+			defer server.Close()
+
+			client, err := zendesk_webhook_api.NewWebhookApi(server.URL, zendesk_http.NewBearerTokenAuthenticator("t"), nil)
+			assert.NilError(t, err)
+			r := &webhookResource{client: client}
+
+			planned := verifiedWebhookModel(t, "https://example.com/hook")
+			diags := r.verifyEndpoint(context.Background(), &planned, tt.state)
+			if tt.wantError == "" {
+				assert.Assert(t, !diags.HasError(), "%v", diags)
+				return
+			}
+			assert.Equal(t, len(diags.Errors()), 1)
+			assert.Equal(t, diags.Errors()[0].Detail(), tt.wantError)
+		})
+	}
+}
+
+func TestWebhookCreate_FailedClonePatch(t *testing.T) {
+	ctx := context.Background()
+	const clone = `{"webhook": {"id": "01CLONE", "name": "golden", "endpoint": "https://example.com/golden",
+		"http_method": "POST", "request_format": "json", "status": "active", "subscriptions": ["conditional_ticket_events"],
+		"created_at": "2024-07-25T09:58:03Z", "created_by": "tester"}}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v2/webhooks":
+			assert.Equal(t, r.URL.Query().Get("clone_webhook_id"), "01GOLDEN")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(clone))
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v2/webhooks/01CLONE/signing_secret":
+			_, _ = w.Write([]byte(`{"signing_secret": {"algorithm": "SHA256", "secret": "cloned"}}`))
+		case r.Method == http.MethodPatch && r.URL.Path == "/api/v2/webhooks/01CLONE":
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"errors": [{"code": "InvalidValue", "title": "Invalid value", "detail": "Endpoint is invalid"}]}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+	}))
+	defer server.Close()
+
+	client, err := zendesk_webhook_api.NewWebhookApi(server.URL, zendesk_http.NewBearerTokenAuthenticator("t"), nil)
+	assert.NilError(t, err)
+	r := &webhookResource{client: client}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	var show zendesk_webhook_api.ShowWebhookWrap
+	assert.NilError(t, json.Unmarshal([]byte(`{"JSON200": `+clone+`}`), &show))
+	planModel := &resource_webhook.WebhookModel{Webhook: resource_webhook.NewWebhookValueNull()}
+	diags := resource_webhook.NewWebhookMapper().PutWebhookShowResponseToStateModel(ctx, &show, planModel)
+	assert.Assert(t, !diags.HasError(), "%v", diags)
+	planModel.Webhook.Name = types.StringValue("clone")
+	planModel.Webhook.Endpoint = types.StringValue("not a url")
+	planned := webhookResourceModel{
+		CloneFromId:   types.StringValue("01GOLDEN"),
+		VerifyOnApply: types.ObjectNull(map[string]attr.Type{"payload": types.StringType}),
+	}
+	planned.setWebhookModel(planModel)
+	planned.WebhookId = types.StringUnknown()
+	plan := tfsdk.Plan{Schema: schemaResp.Schema}
+	assert.Assert(t, !plan.Set(ctx, &planned).HasError())
+
+	createResp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: plan.Raw.Copy()}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, createResp)
+	assert.Assert(t, createResp.Diagnostics.HasError(), "the failed patch is reported")
+	var saved webhookResourceModel
+	assert.Assert(t, !createResp.State.Get(ctx, &saved).HasError())
+	assert.Equal(t, saved.WebhookId.ValueString(), "01CLONE", "the clone is tracked, so it is tainted instead of orphaned")
+}
+
+func verifiedWebhookModel(t *testing.T, endpoint string) webhookResourceModel {
+	ctx := context.Background()
+	data := resource_webhook.NewDataValueNull()
+	data.Token = types.StringValue("planned-token")
+	authentication := resource_webhook.NewAuthenticationValueNull()
+	authentication.AuthenticationType = types.StringValue("bearer_token")
+	authentication.AddPosition = types.StringValue("header")
+	var diags diag.Diagnostics
+	authentication.Data, diags = data.ToObjectValue(ctx)
+	assert.Assert(t, !diags.HasError())
+
+	model := webhookResourceModel{}
+	model.Webhook.Authentication, diags = authentication.ToObjectValue(ctx)
+	assert.Assert(t, !diags.HasError())
+	model.Webhook.Endpoint = types.StringValue(endpoint)
+	model.Webhook.HttpMethod = types.StringValue("POST")
+	model.Webhook.RequestFormat = types.StringValue("json")
+	model.Webhook.CustomHeaders = types.MapNull(types.StringType)
+	model.VerifyOnApply, diags = types.ObjectValue(map[string]attr.Type{"payload": types.StringType},
+		map[string]attr.Value{"payload": types.StringValue(`{"ping":true}`)})
+	assert.Assert(t, !diags.HasError())
+	return model
+}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"terraform-provider-zendesk/internal/datasource_webhook_signing_secret"
+	"terraform-provider-zendesk/zendesk_webhook_api"
+)
+
+var (
+	_ datasource.DataSource              = (*webhookSigningSecretDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*webhookSigningSecretDataSource)(nil)
+)
+
+func NewWebhookSigningSecretDataSource() datasource.DataSource {
+	return &webhookSigningSecretDataSource{}
+}
+
+type webhookSigningSecretDataSource struct {
+	client *zendesk_webhook_api.WebhookApi
+}
+
+func (d *webhookSigningSecretDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook_signing_secret"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *webhookSigningSecretDataSource) Configure(ctx context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := request.ProviderData.(zendeskProviderData)
+
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *zendeskProviderData, got: %T. Please report this issue to the provider developers.",
+				request.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.webhookApi
+}
+
+func (d *webhookSigningSecretDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_webhook_signing_secret.WebhookSigningSecretDataSourceSchema(ctx)
+	resp.Schema.Description = "Reads the secret Zendesk signs the requests of a webhook with, to verify the signatures in the receiving service."
+}
+
+func (d *webhookSigningSecretDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config datasource_webhook_signing_secret.WebhookSigningSecretModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webhookId := config.WebhookId.ValueString()
+	tflog.Debug(ctx, "Read webhook signing secret data source", map[string]any{"webhook_id": webhookId})
+
+	reqEditors := make([]zendesk_webhook_api.RequestEditorFn, 0)
+	secretResponse, err := d.client.GetClient().ShowWebhookSigningSecretWithResponse(ctx, webhookId, reqEditors...)
+	if err != nil {
+		tflog.Error(ctx, "Error reading webhook signing secret from the API: ", map[string]interface{}{"error": err})
+		resp.Diagnostics.AddError("Error reading webhook signing secret from the API", err.Error())
+		return
+	}
+
+	if secretResponse.StatusCode() != 200 || secretResponse.JSON200 == nil || secretResponse.JSON200.SigningSecret == nil {
+		message := fmt.Sprintf("Error reading webhook signing secret from the API, StatusCode: %v , Request Param: %v", secretResponse.StatusCode(), webhookId)
+		tflog.Error(ctx, message, map[string]interface{}{"error": string(secretResponse.Body)})
+		resp.Diagnostics.AddError("Error reading webhook signing secret from the API", fmt.Sprintf("%+v", string(secretResponse.Body)))
+		return
+	}
+
+	signingSecret, diags := datasource_webhook_signing_secret.NewSigningSecretValue(
+		datasource_webhook_signing_secret.SigningSecretValue{}.AttributeTypes(ctx),
+		map[string]attr.Value{
+			"algorithm": types.StringPointerValue(secretResponse.JSON200.SigningSecret.Algorithm),
+			"secret":    types.StringPointerValue(secretResponse.JSON200.SigningSecret.Secret),
+		},
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	config.SigningSecret = signingSecret
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
@@ -0,0 +1,366 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strconv"
+	"strings"
+	"terraform-provider-zendesk/zendesk_webhook_api"
+	"time"
+)
+
+var (
+	_ resource.Resource                   = (*webhookSigningSecretRotationResource)(nil)
+	_ resource.ResourceWithConfigure      = (*webhookSigningSecretRotationResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*webhookSigningSecretRotationResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*webhookSigningSecretRotationResource)(nil)
+)
+
+func NewWebhookSigningSecretRotationResource() resource.Resource {
+	return &webhookSigningSecretRotationResource{}
+}
+
+type webhookSigningSecretRotationResource struct {
+	client *zendesk_webhook_api.WebhookApi
+}
+
+type webhookSigningSecretRotationModel struct {
+	WebhookId        types.String `tfsdk:"webhook_id"`
+	RotationTriggers types.Map    `tfsdk:"rotation_triggers"`
+	RotateAfter      types.String `tfsdk:"rotate_after"`
+	RotatedAt        types.String `tfsdk:"rotated_at"`
+	RotationDue      types.String `tfsdk:"rotation_due"`
+	Algorithm        types.String `tfsdk:"algorithm"`
+	Secret           types.String `tfsdk:"secret"`
+}
+
+func (r *webhookSigningSecretRotationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook_signing_secret_rotation"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *webhookSigningSecretRotationResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := request.ProviderData.(zendeskProviderData)
+
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *zendeskProviderData, got: %T. Please report this issue to the provider developers.",
+				request.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.webhookApi
+}
+
+func (r *webhookSigningSecretRotationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Rotates the signing secret of a webhook when it is created, when a value of `rotation_triggers` changes " +
+			"or when `rotate_after` has passed since the last rotation. Destroying the resource does not change the secret.",
+		Attributes: map[string]schema.Attribute{
+			"webhook_id": schema.StringAttribute{
+				Description: "Id of the webhook whose signing secret is rotated.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rotation_triggers": schema.MapAttribute{
+				Description: "Arbitrary values which rotate the secret when they change, e.g. a date or a version.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"rotate_after": schema.StringAttribute{
+				Description: "Rotates the secret in the first apply after this period since the last rotation, " +
+					"in days like `90d` or as a duration like `2160h`.",
+				Optional: true,
+			},
+			"rotated_at": schema.StringAttribute{
+				Description: "Time of the last rotation in RFC 3339 format.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rotation_due": schema.StringAttribute{
+				Description: "Time from which the next apply rotates the secret in RFC 3339 format, null without `rotate_after`.",
+				Computed:    true,
+			},
+			"algorithm": schema.StringAttribute{
+				Description: "Algorithm of the signature, e.g. `SHA256`.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"secret": schema.StringAttribute{
+				Description: "The new signing secret.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *webhookSigningSecretRotationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config webhookSigningSecretRotationModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.RotateAfter.IsNull() || config.RotateAfter.IsUnknown() {
+		return
+	}
+	if _, err := parseRotationPeriod(config.RotateAfter.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("rotate_after"), "Invalid Rotation Period", err.Error())
+	}
+}
+
+// ModifyPlan plans a rotation in the next Update when rotate_after has passed since the last rotation, the unknown
+// rotated_at tells Update to rotate.
+func (r *webhookSigningSecretRotationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan webhookSigningSecretRotationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if req.State.Raw.IsNull() || plan.RotatedAt.IsUnknown() {
+		plan.RotationDue = types.StringUnknown()
+		if plan.RotateAfter.IsNull() {
+			plan.RotationDue = types.StringNull()
+		}
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		return
+	}
+
+	if plan.RotateAfter.IsUnknown() {
+		plan.RotationDue = types.StringUnknown()
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		return
+	}
+
+	rotationDue, err := rotationDueTime(plan.RotatedAt.ValueString(), plan.RotateAfter.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("rotate_after"), "Invalid Rotation Period", err.Error())
+		return
+	}
+	if rotationDue == nil {
+		plan.RotationDue = types.StringNull()
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		return
+	}
+
+	if time.Now().Before(*rotationDue) {
+		plan.RotationDue = types.StringValue(rotationDue.Format(time.RFC3339))
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		return
+	}
+
+	tflog.Info(ctx, "Webhook signing secret rotation is due", map[string]any{
+		"webhook_id":   plan.WebhookId.ValueString(),
+		"rotation_due": rotationDue.Format(time.RFC3339),
+	})
+	plan.RotatedAt = types.StringUnknown()
+	plan.RotationDue = types.StringUnknown()
+	plan.Algorithm = types.StringUnknown()
+	plan.Secret = types.StringUnknown()
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *webhookSigningSecretRotationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan webhookSigningSecretRotationModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.rotate(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// rotate resets the signing secret of the webhook and stores the new secret, the time of the rotation and the time
+// the next rotation is due in the model.
+func (r *webhookSigningSecretRotationResource) rotate(ctx context.Context, model *webhookSigningSecretRotationModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	webhookId := model.WebhookId.ValueString()
+	tflog.Debug(ctx, "Rotate webhook signing secret", map[string]any{"webhook_id": webhookId})
+
+	reqEditors := make([]zendesk_webhook_api.RequestEditorFn, 0)
+	resetResponse, err := r.client.GetClient().ResetWebhookSigningSecretWithResponse(ctx, webhookId, reqEditors...)
+	if err != nil {
+		tflog.Error(ctx, "Error resetting webhook signing secret: ", map[string]interface{}{"error": err})
+		diags.AddError("Error resetting webhook signing secret", err.Error())
+		return diags
+	}
+
+	if resetResponse.StatusCode() != 201 || resetResponse.JSON201 == nil || resetResponse.JSON201.SigningSecret == nil {
+		message := fmt.Sprintf("Error resetting webhook signing secret, StatusCode: %v , Request Param: %v", resetResponse.StatusCode(), webhookId)
+		tflog.Error(ctx, message, map[string]interface{}{"error": string(resetResponse.Body)})
+		addAPIError(&diags, "Error resetting webhook signing secret", resetResponse.HTTPResponse, resetResponse.Body, nil)
+		return diags
+	}
+
+	model.RotatedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+	model.Algorithm = types.StringPointerValue(resetResponse.JSON201.SigningSecret.Algorithm)
+	model.Secret = types.StringPointerValue(resetResponse.JSON201.SigningSecret.Secret)
+	diags.Append(setRotationDue(model)...)
+	if !diags.HasError() {
+		tflog.Info(ctx, "Rotated webhook signing secret", map[string]any{"webhook_id": webhookId})
+	}
+	return diags
+}
+
+// setRotationDue sets rotation_due from the time of the last rotation and rotate_after.
+func setRotationDue(model *webhookSigningSecretRotationModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	rotationDue, err := rotationDueTime(model.RotatedAt.ValueString(), model.RotateAfter.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("rotate_after"), "Invalid Rotation Period", err.Error())
+		return diags
+	}
+	model.RotationDue = types.StringNull()
+	if rotationDue != nil {
+		model.RotationDue = types.StringValue(rotationDue.Format(time.RFC3339))
+	}
+	return diags
+}
+
+// Read refreshes the secret, so a rotation outside of Terraform reaches the downstream secret stores as well.
+func (r *webhookSigningSecretRotationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state webhookSigningSecretRotationModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webhookId := state.WebhookId.ValueString()
+	reqEditors := make([]zendesk_webhook_api.RequestEditorFn, 0)
+	secretResponse, err := r.client.GetClient().ShowWebhookSigningSecretWithResponse(ctx, webhookId, reqEditors...)
+	if err != nil {
+		tflog.Error(ctx, "Error reading webhook signing secret from the API: ", map[string]interface{}{"error": err})
+		resp.Diagnostics.AddError("Error reading webhook signing secret from the API", err.Error())
+		return
+	}
+
+	if removeResourceWhenNotFound(ctx, secretResponse.StatusCode(), "zendesk_webhook_signing_secret_rotation", webhookId, resp) {
+		return
+	}
+
+	if secretResponse.StatusCode() != 200 || secretResponse.JSON200 == nil || secretResponse.JSON200.SigningSecret == nil {
+		message := fmt.Sprintf("Error reading webhook signing secret from the API, StatusCode: %v , Request Param: %v", secretResponse.StatusCode(), webhookId)
+		tflog.Error(ctx, message, map[string]interface{}{"error": string(secretResponse.Body)})
+		addAPIError(&resp.Diagnostics, "Error reading webhook signing secret from the API", secretResponse.HTTPResponse, secretResponse.Body, nil)
+		return
+	}
+
+	state.Algorithm = types.StringPointerValue(secretResponse.JSON200.SigningSecret.Algorithm)
+	state.Secret = types.StringPointerValue(secretResponse.JSON200.SigningSecret.Secret)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update rotates the secret when ModifyPlan found the rotation due, otherwise it only stores a changed rotate_after.
+// All other changes replace the resource.
+func (r *webhookSigningSecretRotationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan webhookSigningSecretRotationModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.RotatedAt.IsUnknown() {
+		resp.Diagnostics.Append(r.rotate(ctx, &plan)...)
+	} else {
+		resp.Diagnostics.Append(setRotationDue(&plan)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the rotation from the state, the webhook keeps its current secret.
+func (r *webhookSigningSecretRotationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state webhookSigningSecretRotationModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Removed webhook signing secret rotation from the state", map[string]any{"webhook_id": state.WebhookId.ValueString()})
+}
+
+// rotationDueTime returns the time from which the secret rotated at rotatedAt is due for rotation,
+// nil when there is no rotation period.
+func rotationDueTime(rotatedAt string, rotateAfter string) (*time.Time, error) {
+	if rotateAfter == "" {
+		return nil, nil
+	}
+	period, err := parseRotationPeriod(rotateAfter)
+	if err != nil {
+		return nil, err
+	}
+	rotated, err := time.Parse(time.RFC3339, rotatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time of the last rotation %q: %w", rotatedAt, err)
+	}
+	due := rotated.Add(period)
+	return &due, nil
+}
+
+// parseRotationPeriod parses a period in days like "90d" or a Go duration like "2160h".
+func parseRotationPeriod(value string) (time.Duration, error) {
+	var period time.Duration
+	if days, found := strings.CutSuffix(value, "d"); found {
+		count, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, fmt.Errorf("invalid rotation period %q, expected days like \"90d\" or a duration like \"2160h\"", value)
+		}
+		period = time.Duration(count) * 24 * time.Hour
+	} else {
+		var err error
+		period, err = time.ParseDuration(value)
+		if err != nil {
+			return 0, fmt.Errorf("invalid rotation period %q, expected days like \"90d\" or a duration like \"2160h\"", value)
+		}
+	}
+	if period <= 0 {
+		return 0, fmt.Errorf("invalid rotation period %q, it must be positive", value)
+	}
+	return period, nil
+}
@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gotest.tools/v3/assert"
+	"terraform-provider-zendesk/zendesk_http"
+	"terraform-provider-zendesk/zendesk_webhook_api"
+)
+
+func TestParseRotationPeriod(t *testing.T) {
+	tests := []struct {
+		value     string
+		want      time.Duration
+		wantError string
+	}{
+		{value: "90d", want: 90 * 24 * time.Hour},
+		{value: "2160h", want: 2160 * time.Hour},
+		{value: "1h30m", want: 90 * time.Minute},
+		{value: "0d", wantError: `invalid rotation period "0d", it must be positive`},
+		{value: "-5h", wantError: `invalid rotation period "-5h", it must be positive`},
+		{value: "ninety days", wantError: `invalid rotation period "ninety days", expected days like "90d" or a duration like "2160h"`},
+		{value: "3w", wantError: `invalid rotation period "3w", expected days like "90d" or a duration like "2160h"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := parseRotationPeriod(tt.value)
+			if tt.wantError != "" {
+				assert.Error(t, err, tt.wantError)
+				return
+			}
+			assert.NilError(t, err)
+			assert.Equal(t, got, tt.want)
+		})
+	}
+}
+
+func TestRotationDueTime(t *testing.T) {
+	due, err := rotationDueTime("2024-01-01T00:00:00Z", "90d")
+	assert.NilError(t, err)
+	assert.Equal(t, due.Format(time.RFC3339), "2024-03-31T00:00:00Z")
+
+	due, err = rotationDueTime("2024-01-01T00:00:00Z", "")
+	assert.NilError(t, err)
+	assert.Assert(t, due == nil, "no rotation is due without a rotation period")
+}
+
+func TestWebhookSigningSecretRotation_DueRotation(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, http.MethodPost)
+		assert.Equal(t, r.URL.Path, "/api/v2/webhooks/01ABC/signing_secret")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"signing_secret":{"algorithm":"SHA256","secret":"new"}}`))
+	}))
+	defer server.Close()
+
+	client, err := zendesk_webhook_api.NewWebhookApi(server.URL, zendesk_http.NewBearerTokenAuthenticator("t"), nil)
+	assert.NilError(t, err)
+	r := &webhookSigningSecretRotationResource{client: client}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	rotatedAt := time.Now().UTC().Add(-91 * 24 * time.Hour).Format(time.RFC3339)
+	current := webhookSigningSecretRotationModel{
+		WebhookId:        types.StringValue("01ABC"),
+		RotationTriggers: types.MapNull(types.StringType),
+		RotateAfter:      types.StringValue("90d"),
+		RotatedAt:        types.StringValue(rotatedAt),
+		RotationDue:      types.StringValue(time.Now().UTC().Add(-24 * time.Hour).Format(time.RFC3339)),
+		Algorithm:        types.StringValue("SHA256"),
+		Secret:           types.StringValue("old"),
+	}
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	assert.Assert(t, !state.Set(ctx, &current).HasError())
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: state.Raw}
+
+	modifyResp := &resource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{State: state, Plan: plan}, modifyResp)
+	assert.Assert(t, !modifyResp.Diagnostics.HasError(), "%v", modifyResp.Diagnostics)
+	assert.Equal(t, len(modifyResp.RequiresReplace), 0, "the rotation happens in Update")
+	var planned webhookSigningSecretRotationModel
+	assert.Assert(t, !modifyResp.Plan.Get(ctx, &planned).HasError())
+	assert.Assert(t, planned.RotatedAt.IsUnknown())
+	assert.Assert(t, planned.Secret.IsUnknown())
+
+	updateResp := &resource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Update(ctx, resource.UpdateRequest{State: state, Plan: modifyResp.Plan}, updateResp)
+	assert.Assert(t, !updateResp.Diagnostics.HasError(), "%v", updateResp.Diagnostics)
+	var updated webhookSigningSecretRotationModel
+	assert.Assert(t, !updateResp.State.Get(ctx, &updated).HasError())
+	assert.Equal(t, updated.Secret.ValueString(), "new")
+	assert.Equal(t, updated.Algorithm.ValueString(), "SHA256")
+	newRotatedAt, err := time.Parse(time.RFC3339, updated.RotatedAt.ValueString())
+	assert.NilError(t, err)
+	assert.Assert(t, time.Since(newRotatedAt) < time.Minute)
+	assert.Equal(t, updated.RotationDue.ValueString(), newRotatedAt.Add(90*24*time.Hour).Format(time.RFC3339))
+}
@@ -0,0 +1,250 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"terraform-provider-zendesk/zendesk_webhook_api"
+)
+
+var (
+	_ datasource.DataSource                     = (*webhookTestDataSource)(nil)
+	_ datasource.DataSourceWithConfigure        = (*webhookTestDataSource)(nil)
+	_ datasource.DataSourceWithConfigValidators = (*webhookTestDataSource)(nil)
+)
+
+func NewWebhookTestDataSource() datasource.DataSource {
+	return &webhookTestDataSource{}
+}
+
+type webhookTestDataSource struct {
+	client *zendesk_webhook_api.WebhookApi
+}
+
+type webhookTestDataSourceModel struct {
+	WebhookId       types.String `tfsdk:"webhook_id"`
+	Endpoint        types.String `tfsdk:"endpoint"`
+	HttpMethod      types.String `tfsdk:"http_method"`
+	RequestFormat   types.String `tfsdk:"request_format"`
+	CustomHeaders   types.Map    `tfsdk:"custom_headers"`
+	QueryParameters types.Map    `tfsdk:"query_parameters"`
+	Payload         types.String `tfsdk:"payload"`
+	Status          types.Int64  `tfsdk:"status"`
+	ResponsePayload types.String `tfsdk:"response_payload"`
+	ResponseHeaders types.Map    `tfsdk:"response_headers"`
+}
+
+// webhookTestRequest is the body of POST /api/v2/webhooks/test. The values override the settings of
+// the tested webhook, when a webhook id is given.
+type webhookTestRequest struct {
+	Request webhookTestRequestSettings `json:"request"`
+}
+
+type webhookTestRequestSettings struct {
+	Endpoint        *string                 `json:"endpoint,omitempty"`
+	HttpMethod      *string                 `json:"http_method,omitempty"`
+	RequestFormat   *string                 `json:"request_format,omitempty"`
+	CustomHeaders   map[string]string       `json:"custom_headers,omitempty"`
+	QueryParameters []webhookQueryParameter `json:"query_parameters,omitempty"`
+	Payload         *string                 `json:"payload,omitempty"`
+	// Authentication is only set by verify_on_apply of zendesk_webhook, to test the planned authentication
+	Authentication *zendesk_webhook_api.Authentication `json:"authentication,omitempty"`
+}
+
+type webhookQueryParameter struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// webhookTestResponse is what the tested endpoint answered.
+type webhookTestResponse struct {
+	Status  int
+	Payload string
+	Headers map[string]string
+}
+
+func (r webhookTestResponse) isSuccess() bool {
+	return r.Status >= 200 && r.Status < 300
+}
+
+func (d *webhookTestDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook_test"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *webhookTestDataSource) Configure(ctx context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := request.ProviderData.(zendeskProviderData)
+
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *zendeskProviderData, got: %T. Please report this issue to the provider developers.",
+				request.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.webhookApi
+}
+
+func (d *webhookTestDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lets Zendesk send a test request to a webhook endpoint and fails when the endpoint does not answer with a 2xx status. " +
+			"Either test an existing webhook with its authentication by `webhook_id`, or an endpoint that is not a webhook yet.",
+		Attributes: map[string]schema.Attribute{
+			"webhook_id": schema.StringAttribute{
+				Description: "Id of the webhook to test. The other request attributes override its settings for the test.",
+				Optional:    true,
+			},
+			"endpoint": schema.StringAttribute{
+				Description: "URL the test request is sent to.",
+				Optional:    true,
+			},
+			"http_method": schema.StringAttribute{
+				Description: "HTTP method of the test request, e.g. `POST`.",
+				Optional:    true,
+			},
+			"request_format": schema.StringAttribute{
+				Description: "Format of the test request, `json`, `xml` or `form_encoded`.",
+				Optional:    true,
+			},
+			"custom_headers": schema.MapAttribute{
+				Description: "Headers added to the test request.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"query_parameters": schema.MapAttribute{
+				Description: "Query parameters added to the test request.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"payload": schema.StringAttribute{
+				Description: "Body of the test request. Zendesk sends a sample payload when not set.",
+				Optional:    true,
+			},
+			"status": schema.Int64Attribute{
+				Description: "HTTP status the endpoint answered with.",
+				Computed:    true,
+			},
+			"response_payload": schema.StringAttribute{
+				Description: "Body the endpoint answered with.",
+				Computed:    true,
+			},
+			"response_headers": schema.MapAttribute{
+				Description: "Headers the endpoint answered with.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *webhookTestDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.AtLeastOneOf(path.MatchRoot("webhook_id"), path.MatchRoot("endpoint")),
+	}
+}
+
+func (d *webhookTestDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config webhookTestDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings := webhookTestRequestSettings{
+		Endpoint:      config.Endpoint.ValueStringPointer(),
+		HttpMethod:    config.HttpMethod.ValueStringPointer(),
+		RequestFormat: config.RequestFormat.ValueStringPointer(),
+		Payload:       config.Payload.ValueStringPointer(),
+	}
+	resp.Diagnostics.Append(config.CustomHeaders.ElementsAs(ctx, &settings.CustomHeaders, false)...)
+	var queryParameters map[string]string
+	resp.Diagnostics.Append(config.QueryParameters.ElementsAs(ctx, &queryParameters, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for key, value := range queryParameters {
+		settings.QueryParameters = append(settings.QueryParameters, webhookQueryParameter{Key: key, Value: value})
+	}
+
+	testResponse, err := testWebhook(ctx, d.client, config.WebhookId.ValueStringPointer(), settings)
+	if err != nil {
+		tflog.Error(ctx, "Error testing the webhook: ", map[string]interface{}{"error": err})
+		resp.Diagnostics.AddError("Error testing the webhook", err.Error())
+		return
+	}
+
+	config.Status = types.Int64Value(int64(testResponse.Status))
+	config.ResponsePayload = types.StringValue(testResponse.Payload)
+	responseHeaders := make(map[string]attr.Value, len(testResponse.Headers))
+	for key, value := range testResponse.Headers {
+		responseHeaders[key] = types.StringValue(value)
+	}
+	responseHeadersValue, diags := types.MapValue(types.StringType, responseHeaders)
+	resp.Diagnostics.Append(diags...)
+	config.ResponseHeaders = responseHeadersValue
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !testResponse.isSuccess() {
+		resp.Diagnostics.AddError(
+			"Webhook Endpoint Test Failed",
+			fmt.Sprintf("The webhook endpoint answered the test request with the status %d: %s", testResponse.Status, testResponse.Payload),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// testWebhook lets Zendesk send a test request, for an existing webhook when webhookId is set.
+// It only fails when Zendesk cannot run the test, the caller decides about the status of the endpoint.
+func testWebhook(ctx context.Context, client *zendesk_webhook_api.WebhookApi, webhookId *string, settings webhookTestRequestSettings) (*webhookTestResponse, error) {
+	body, err := json.Marshal(webhookTestRequest{Request: settings})
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.Debug(ctx, "Testing webhook endpoint", map[string]any{"webhook_id": webhookId, "endpoint": settings.Endpoint})
+	params := &zendesk_webhook_api.TestWebhookParams{WebhookId: webhookId}
+	reqEditors := make([]zendesk_webhook_api.RequestEditorFn, 0)
+	response, err := client.GetClient().TestWebhookWithBodyWithResponse(ctx, params, "application/json", bytes.NewReader(body), reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode() != 200 || response.JSON200 == nil || response.JSON200.Response == nil {
+		return nil, fmt.Errorf("the test request was rejected with the status %v: %s", response.StatusCode(), string(response.Body))
+	}
+
+	testResponse := &webhookTestResponse{Headers: map[string]string{}}
+	if response.JSON200.Response.Status != nil {
+		testResponse.Status = *response.JSON200.Response.Status
+	}
+	if response.JSON200.Response.Payload != nil {
+		testResponse.Payload = *response.JSON200.Response.Payload
+	}
+	if response.JSON200.Response.Headers != nil {
+		for _, header := range *response.JSON200.Response.Headers {
+			if header.Key != nil && header.Value != nil {
+				testResponse.Headers[*header.Key] = *header.Value
+			}
+		}
+	}
+	tflog.Debug(ctx, "Tested webhook endpoint", map[string]any{"webhook_id": webhookId, "status": testResponse.Status})
+	return testResponse, nil
+}
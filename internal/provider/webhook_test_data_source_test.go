@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	"terraform-provider-zendesk/zendesk_http"
+	"terraform-provider-zendesk/zendesk_webhook_api"
+)
+
+func TestTestWebhook(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		body        string
+		wantStatus  int
+		wantSuccess bool
+		wantError   string
+	}{
+		{name: "endpoint answers 200",
+			status:      http.StatusOK,
+			body:        `{"response":{"status":200,"payload":"ok","headers":[{"key":"Content-Type","value":"text/plain"}]}}`,
+			wantStatus:  200,
+			wantSuccess: true},
+		{name: "endpoint answers 401",
+			status:     http.StatusOK,
+			body:       `{"response":{"status":401,"payload":"unauthorized","headers":[]}}`,
+			wantStatus: 401},
+		{name: "Zendesk rejects the test",
+			status:    http.StatusBadRequest,
+			body:      `{"errors":[{"code":"InvalidEndpoint","title":"Invalid endpoint"}]}`,
+			wantError: `the test request was rejected with the status 400: {"errors":[{"code":"InvalidEndpoint","title":"Invalid endpoint"}]}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, r.URL.Path, "/api/v2/webhooks/test")
+				assert.Equal(t, r.URL.Query().Get("webhook_id"), "01ABC")
+				body, _ := io.ReadAll(r.Body)
+				var request webhookTestRequest
+				assert.NilError(t, json.Unmarshal(body, &request))
+				assert.Equal(t, *request.Request.Payload, `{"ping":true}`)
+				assert.Assert(t, request.Request.Endpoint == nil, "the stored endpoint of the webhook must be tested")
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			client, err := zendesk_webhook_api.NewWebhookApi(server.URL, zendesk_http.NewBearerTokenAuthenticator("t"), nil)
+			assert.NilError(t, err)
+
+			webhookId := "01ABC"
+			payload := `{"ping":true}`
+			response, err := testWebhook(context.Background(), client, &webhookId, webhookTestRequestSettings{Payload: &payload})
+			if tt.wantError != "" {
+				assert.Error(t, err, tt.wantError)
+				return
+			}
+			assert.NilError(t, err)
+			assert.Equal(t, response.Status, tt.wantStatus)
+			assert.Equal(t, response.isSuccess(), tt.wantSuccess)
+		})
+	}
+}
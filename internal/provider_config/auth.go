@@ -0,0 +1,61 @@
+package provider_config
+
+import (
+	"context"
+	"net/http"
+	"terraform-provider-zendesk/zendesk_http"
+)
+
+const (
+	AuthMethodAPIToken          = "API token"
+	AuthMethodOAuthToken        = "OAuth access token"
+	AuthMethodClientCredentials = "OAuth client credentials"
+
+	// DefaultOAuthScope is requested in the client credentials exchange when no oauth_scope is set.
+	DefaultOAuthScope = "read write"
+)
+
+// ConfiguredAuthMethods lists the authentication methods for which values were found,
+// in order of precedence.
+func (c *Credentials) ConfiguredAuthMethods() []string {
+	methods := make([]string, 0)
+	if c.OAuthToken.Value != "" {
+		methods = append(methods, AuthMethodOAuthToken)
+	}
+	if c.OAuthClientId.Value != "" || c.OAuthSecret.Value != "" {
+		methods = append(methods, AuthMethodClientCredentials)
+	}
+	if c.Email.Value != "" || c.Token.Value != "" {
+		methods = append(methods, AuthMethodAPIToken)
+	}
+	return methods
+}
+
+// AuthMethod is the authentication method the provider uses. An OAuth access token takes
+// precedence over OAuth client credentials, which take precedence over email and API token.
+func (c *Credentials) AuthMethod() string {
+	methods := c.ConfiguredAuthMethods()
+	if len(methods) == 0 {
+		return AuthMethodAPIToken
+	}
+	return methods[0]
+}
+
+// NewAuthenticator creates the Authenticator for AuthMethod. OAuth client credentials are
+// exchanged for an access token against serverUrl right away, so wrong credentials fail
+// the provider configuration instead of the first API call.
+func (c *Credentials) NewAuthenticator(ctx context.Context, httpClient *http.Client, serverUrl string) (zendesk_http.Authenticator, error) {
+	switch c.AuthMethod() {
+	case AuthMethodOAuthToken:
+		return zendesk_http.NewBearerTokenAuthenticator(c.OAuthToken.Value), nil
+	case AuthMethodClientCredentials:
+		scope := firstNonEmpty(c.OAuthScope.Value, DefaultOAuthScope)
+		accessToken, err := zendesk_http.ExchangeClientCredentials(ctx, httpClient, serverUrl, c.OAuthClientId.Value, c.OAuthSecret.Value, scope)
+		if err != nil {
+			return nil, err
+		}
+		return zendesk_http.NewBearerTokenAuthenticator(accessToken), nil
+	default:
+		return zendesk_http.NewAPITokenAuthenticator(c.Email.Value, c.Token.Value), nil
+	}
+}
@@ -0,0 +1,242 @@
+package provider_config
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	AccountEnvVar         = "ZENDESK_ACCOUNT"
+	EmailEnvVar           = "ZENDESK_EMAIL"
+	TokenEnvVar           = "ZENDESK_TOKEN"
+	OAuthTokenEnvVar      = "ZENDESK_OAUTH_TOKEN"
+	OAuthClientIdEnvVar   = "ZENDESK_OAUTH_CLIENT_ID"
+	OAuthSecretEnvVar     = "ZENDESK_OAUTH_CLIENT_SECRET"
+	OAuthScopeEnvVar      = "ZENDESK_OAUTH_SCOPE"
+	ProfileEnvVar         = "ZENDESK_PROFILE"
+	CredentialsFileEnvVar = "ZENDESK_CREDENTIALS_FILE"
+
+	DefaultProfile = "default"
+)
+
+// DefaultCredentialsFile is the credentials file read when neither the configuration
+// nor ZENDESK_CREDENTIALS_FILE name one, relative to the user's home directory.
+var DefaultCredentialsFile = filepath.Join(".zendesk", "credentials")
+
+// ExplicitCredentials holds the values set in the provider configuration block.
+// Empty strings are treated as unset.
+type ExplicitCredentials struct {
+	Account         string
+	ApiUrl          string
+	Email           string
+	Token           string
+	OAuthToken      string
+	OAuthClientId   string
+	OAuthSecret     string
+	OAuthScope      string
+	Profile         string
+	CredentialsFile string
+}
+
+// ResolvedValue is a single credential value together with a human-readable
+// description of where it was found. Source is empty when no source supplied it.
+type ResolvedValue struct {
+	Value  string
+	Source string
+}
+
+// Credentials is the result of walking the credential chain, it includes the API endpoint
+// as it is configured the same way.
+type Credentials struct {
+	Account       ResolvedValue
+	ApiUrl        ResolvedValue
+	Email         ResolvedValue
+	Token         ResolvedValue
+	OAuthToken    ResolvedValue
+	OAuthClientId ResolvedValue
+	OAuthSecret   ResolvedValue
+	OAuthScope    ResolvedValue
+
+	// Profile and CredentialsFile describe the credentials file that took part in the chain,
+	// CredentialsFile is empty when no file was read.
+	Profile         string
+	CredentialsFile string
+}
+
+// ResolveCredentials looks up every credential value in order from the explicit
+// provider configuration, the ZENDESK_* environment variables and the named profile
+// of the credentials file. The first source providing a non-empty value wins.
+func ResolveCredentials(explicit ExplicitCredentials) (*Credentials, error) {
+	profile := firstNonEmpty(explicit.Profile, os.Getenv(ProfileEnvVar), DefaultProfile)
+
+	credentialsFile, fileRequired := explicit.CredentialsFile, true
+	if credentialsFile == "" {
+		credentialsFile = os.Getenv(CredentialsFileEnvVar)
+	}
+	if credentialsFile == "" {
+		// the default file is optional, it is silently skipped when it is missing
+		fileRequired = false
+		home, err := os.UserHomeDir()
+		if err == nil {
+			credentialsFile = filepath.Join(home, DefaultCredentialsFile)
+		}
+	}
+
+	var profileValues map[string]string
+	if credentialsFile != "" {
+		profiles, err := readCredentialsFile(credentialsFile)
+		switch {
+		case err == nil:
+			values, ok := profiles[profile]
+			if !ok && (fileRequired || profile != DefaultProfile) {
+				return nil, fmt.Errorf("profile %q not found in credentials file %s", profile, credentialsFile)
+			}
+			profileValues = values
+		case os.IsNotExist(err) && !fileRequired:
+			credentialsFile = ""
+		default:
+			return nil, fmt.Errorf("reading credentials file %s: %w", credentialsFile, err)
+		}
+	}
+
+	fileSource := fmt.Sprintf("profile %q of credentials file %s", profile, credentialsFile)
+	lookup := func(explicitValue string, envVar string, fileKey string) ResolvedValue {
+		if explicitValue != "" {
+			return ResolvedValue{Value: explicitValue, Source: "provider configuration"}
+		}
+		if value := os.Getenv(envVar); value != "" {
+			return ResolvedValue{Value: value, Source: "environment variable " + envVar}
+		}
+		if value := profileValues[fileKey]; value != "" {
+			return ResolvedValue{Value: value, Source: fileSource}
+		}
+		return ResolvedValue{}
+	}
+
+	credentials := &Credentials{
+		Account:         lookup(explicit.Account, AccountEnvVar, "account"),
+		ApiUrl:          lookup(explicit.ApiUrl, ApiUrlEnvVar, "api_url"),
+		Email:           lookup(explicit.Email, EmailEnvVar, "email"),
+		Token:           lookup(explicit.Token, TokenEnvVar, "token"),
+		OAuthToken:      lookup(explicit.OAuthToken, OAuthTokenEnvVar, "oauth_token"),
+		OAuthClientId:   lookup(explicit.OAuthClientId, OAuthClientIdEnvVar, "oauth_client_id"),
+		OAuthSecret:     lookup(explicit.OAuthSecret, OAuthSecretEnvVar, "oauth_client_secret"),
+		OAuthScope:      lookup(explicit.OAuthScope, OAuthScopeEnvVar, "oauth_scope"),
+		Profile:         profile,
+		CredentialsFile: credentialsFile,
+	}
+	return credentials, nil
+}
+
+type namedValue struct {
+	name  string
+	value ResolvedValue
+}
+
+func (c *Credentials) namedValues() []namedValue {
+	return []namedValue{
+		{"account", c.Account},
+		{"api_url", c.ApiUrl},
+		{"email", c.Email},
+		{"token", c.Token},
+		{"oauth_token", c.OAuthToken},
+		{"oauth_client_id", c.OAuthClientId},
+		{"oauth_client_secret", c.OAuthSecret},
+		{"oauth_scope", c.OAuthScope},
+	}
+}
+
+// Sources lists where each of the resolved values came from, keyed by attribute name.
+// Values which were not found are left out.
+func (c *Credentials) Sources() map[string]string {
+	sources := make(map[string]string)
+	for _, attribute := range c.namedValues() {
+		if attribute.value.Source != "" {
+			sources[attribute.name] = attribute.value.Source
+		}
+	}
+	return sources
+}
+
+// SourceSummary describes in one line which source supplied each value, e.g. for a diagnostic.
+func (c *Credentials) SourceSummary() string {
+	parts := make([]string, 0)
+	for _, attribute := range c.namedValues() {
+		if attribute.value.Source != "" {
+			parts = append(parts, attribute.name+" from "+attribute.value.Source)
+		}
+	}
+	if len(parts) == 0 {
+		return "no values set"
+	}
+	return strings.Join(parts, ", ")
+}
+
+// HasMixedSources reports whether the set values were supplied by more than one source.
+func (c *Credentials) HasMixedSources() bool {
+	seen := make(map[string]bool)
+	for _, source := range c.Sources() {
+		if source != "" {
+			seen[source] = true
+		}
+	}
+	return len(seen) > 1
+}
+
+// readCredentialsFile parses an INI style credentials file:
+//
+//	[default]
+//	account = example
+//	email   = jdoe@example.com
+//	token   = ...
+//
+// Lines starting with '#' or ';' are comments.
+func readCredentialsFile(name string) (map[string]map[string]string, error) {
+	file, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	profiles := make(map[string]map[string]string)
+	var current map[string]string
+	scanner := bufio.NewScanner(file)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			profileName := strings.TrimSpace(line[1 : len(line)-1])
+			current = profiles[profileName]
+			if current == nil {
+				current = make(map[string]string)
+				profiles[profileName] = current
+			}
+			continue
+		}
+		key, value, found := strings.Cut(line, "=")
+		if !found || current == nil {
+			return nil, fmt.Errorf("line %d: expected a [profile] header or a key = value pair", lineNumber)
+		}
+		current[strings.TrimSpace(key)] = strings.Trim(strings.TrimSpace(value), `"`)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return profiles, nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,132 @@
+package provider_config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+const testCredentialsFile = `
+# comment
+[default]
+account = file-account
+email = file@example.com
+token = "file-token"
+
+[staging]
+account = staging-account
+email = staging@example.com
+token = staging-token
+`
+
+func writeCredentialsFile(t *testing.T) string {
+	name := filepath.Join(t.TempDir(), "credentials")
+	err := os.WriteFile(name, []byte(testCredentialsFile), 0o600)
+	assert.NilError(t, err)
+	return name
+}
+
+func clearCredentialEnv(t *testing.T) {
+	for _, envVar := range []string{AccountEnvVar, ApiUrlEnvVar, EmailEnvVar, TokenEnvVar, OAuthTokenEnvVar, OAuthClientIdEnvVar, OAuthSecretEnvVar, OAuthScopeEnvVar, ProfileEnvVar, CredentialsFileEnvVar} {
+		t.Setenv(envVar, "")
+	}
+	// keep a developer's real ~/.zendesk/credentials out of the tests
+	t.Setenv("HOME", t.TempDir())
+}
+
+func TestResolveCredentials(t *testing.T) {
+	tests := []struct {
+		name         string
+		explicit     func(file string) ExplicitCredentials
+		env          map[string]string
+		wantAccount  ResolvedValue
+		wantEmail    ResolvedValue
+		wantToken    ResolvedValue
+		wantMixed    bool
+		wantErrorMsg string
+	}{
+		{name: "explicit configuration wins",
+			explicit: func(file string) ExplicitCredentials {
+				return ExplicitCredentials{Account: "cfg", Email: "cfg@example.com", Token: "cfg-token", CredentialsFile: file}
+			},
+			env:         map[string]string{AccountEnvVar: "env"},
+			wantAccount: ResolvedValue{"cfg", "provider configuration"},
+			wantEmail:   ResolvedValue{"cfg@example.com", "provider configuration"},
+			wantToken:   ResolvedValue{"cfg-token", "provider configuration"},
+		},
+		{name: "environment before credentials file",
+			explicit: func(file string) ExplicitCredentials {
+				return ExplicitCredentials{Email: "cfg@example.com", CredentialsFile: file}
+			},
+			env:         map[string]string{AccountEnvVar: "env"},
+			wantAccount: ResolvedValue{"env", "environment variable ZENDESK_ACCOUNT"},
+			wantEmail:   ResolvedValue{"cfg@example.com", "provider configuration"},
+			wantToken:   ResolvedValue{"file-token", `profile "default" of credentials file `},
+			wantMixed:   true,
+		},
+		{name: "named profile from the environment",
+			explicit: func(file string) ExplicitCredentials {
+				return ExplicitCredentials{}
+			},
+			env:         map[string]string{ProfileEnvVar: "staging"},
+			wantAccount: ResolvedValue{"staging-account", `profile "staging" of credentials file `},
+			wantEmail:   ResolvedValue{"staging@example.com", `profile "staging" of credentials file `},
+			wantToken:   ResolvedValue{"staging-token", `profile "staging" of credentials file `},
+		},
+		{name: "unknown profile",
+			explicit: func(file string) ExplicitCredentials {
+				return ExplicitCredentials{Profile: "production", CredentialsFile: file}
+			},
+			wantErrorMsg: `profile "production" not found in credentials file `,
+		},
+		{name: "missing explicit credentials file",
+			explicit: func(file string) ExplicitCredentials {
+				return ExplicitCredentials{CredentialsFile: file + ".missing"}
+			},
+			wantErrorMsg: "reading credentials file ",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clearCredentialEnv(t)
+			file := writeCredentialsFile(t)
+			t.Setenv(CredentialsFileEnvVar, file)
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+
+			credentials, err := ResolveCredentials(tt.explicit(file))
+			if tt.wantErrorMsg != "" {
+				assert.ErrorContains(t, err, tt.wantErrorMsg)
+				return
+			}
+			assert.NilError(t, err)
+			assertResolved(t, credentials.Account, tt.wantAccount, file)
+			assertResolved(t, credentials.Email, tt.wantEmail, file)
+			assertResolved(t, credentials.Token, tt.wantToken, file)
+			assert.Equal(t, credentials.HasMixedSources(), tt.wantMixed)
+		})
+	}
+}
+
+func TestResolveCredentials_MissingDefaultFileIsIgnored(t *testing.T) {
+	clearCredentialEnv(t)
+	t.Setenv(TokenEnvVar, "env-token")
+
+	credentials, err := ResolveCredentials(ExplicitCredentials{})
+	assert.NilError(t, err)
+	assert.Equal(t, credentials.CredentialsFile, "")
+	assert.Equal(t, credentials.Account, ResolvedValue{})
+	assert.Equal(t, credentials.Token.Value, "env-token")
+	assert.Equal(t, credentials.SourceSummary(), "token from environment variable ZENDESK_TOKEN")
+}
+
+func assertResolved(t *testing.T, got ResolvedValue, want ResolvedValue, file string) {
+	t.Helper()
+	if want.Source != "" && want.Source[len(want.Source)-1] == ' ' {
+		want.Source += file
+	}
+	assert.Equal(t, got, want)
+}
@@ -0,0 +1,53 @@
+package provider_config
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+const ApiUrlEnvVar = "ZENDESK_API_URL"
+
+// accountRegexp matches Zendesk subdomains, the same rule as used by go-zendesk.
+var accountRegexp = regexp.MustCompile("^[a-z0-9][a-z0-9-]+[a-z0-9]$")
+
+// ParseApiUrl validates an api_url value. It must be an absolute http or https URL with a host
+// and an optional port and path prefix, the API paths (/api/v2/...) are appended to it.
+func ParseApiUrl(rawUrl string) (*url.URL, error) {
+	apiUrl, err := url.Parse(rawUrl)
+	if err != nil {
+		return nil, err
+	}
+	if apiUrl.Scheme != "http" && apiUrl.Scheme != "https" {
+		return nil, fmt.Errorf("%q must use the http or https scheme", rawUrl)
+	}
+	if apiUrl.Hostname() == "" {
+		return nil, fmt.Errorf("%q has no host", rawUrl)
+	}
+	if apiUrl.User != nil || apiUrl.RawQuery != "" || apiUrl.Fragment != "" {
+		return nil, fmt.Errorf("%q must not contain user info, a query or a fragment", rawUrl)
+	}
+	apiUrl.Path = strings.TrimSuffix(apiUrl.Path, "/")
+	apiUrl.RawPath = ""
+	return apiUrl, nil
+}
+
+// ServerUrl is the base URL of the Zendesk API without the /api/v2 path. The api_url takes
+// precedence over the account, which results in https://<account>.zendesk.com.
+func (c *Credentials) ServerUrl() (string, error) {
+	if c.ApiUrl.Value != "" {
+		apiUrl, err := ParseApiUrl(c.ApiUrl.Value)
+		if err != nil {
+			return "", fmt.Errorf("invalid api_url from %s: %w", c.ApiUrl.Source, err)
+		}
+		return apiUrl.String(), nil
+	}
+	if c.Account.Value == "" {
+		return "", nil
+	}
+	if !accountRegexp.MatchString(c.Account.Value) {
+		return "", fmt.Errorf("invalid account %q from %s: expected the subdomain of your Zendesk instance, use api_url for other hosts", c.Account.Value, c.Account.Source)
+	}
+	return fmt.Sprintf("https://%s.zendesk.com", c.Account.Value), nil
+}
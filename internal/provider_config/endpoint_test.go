@@ -0,0 +1,54 @@
+package provider_config
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestCredentials_ServerUrl(t *testing.T) {
+	tests := []struct {
+		name         string
+		credentials  Credentials
+		want         string
+		wantErrorMsg string
+	}{
+		{name: "account",
+			credentials: Credentials{Account: ResolvedValue{"example", "provider configuration"}},
+			want:        "https://example.zendesk.com"},
+		{name: "api_url takes precedence over account",
+			credentials: Credentials{
+				Account: ResolvedValue{"example", "provider configuration"},
+				ApiUrl:  ResolvedValue{"https://support.example.com/", "provider configuration"}},
+			want: "https://support.example.com"},
+		{name: "http with port and path prefix",
+			credentials: Credentials{ApiUrl: ResolvedValue{"http://127.0.0.1:8089/recording", "environment variable ZENDESK_API_URL"}},
+			want:        "http://127.0.0.1:8089/recording"},
+		{name: "nothing set",
+			credentials: Credentials{},
+			want:        ""},
+		{name: "unsupported scheme",
+			credentials:  Credentials{ApiUrl: ResolvedValue{"ftp://example.com", "provider configuration"}},
+			wantErrorMsg: "must use the http or https scheme"},
+		{name: "no host",
+			credentials:  Credentials{ApiUrl: ResolvedValue{"https://", "provider configuration"}},
+			wantErrorMsg: "has no host"},
+		{name: "query",
+			credentials:  Credentials{ApiUrl: ResolvedValue{"https://example.com?x=1", "provider configuration"}},
+			wantErrorMsg: "must not contain user info, a query or a fragment"},
+		{name: "account is not a subdomain",
+			credentials:  Credentials{Account: ResolvedValue{"localhostPort8080", "provider configuration"}},
+			wantErrorMsg: "use api_url for other hosts"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.credentials.ServerUrl()
+			if tt.wantErrorMsg != "" {
+				assert.ErrorContains(t, err, tt.wantErrorMsg)
+				return
+			}
+			assert.NilError(t, err)
+			assert.Equal(t, got, tt.want)
+		})
+	}
+}
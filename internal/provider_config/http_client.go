@@ -0,0 +1,79 @@
+package provider_config
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"terraform-provider-zendesk/zendesk_http"
+)
+
+// HttpSettings configures the HTTP client shared by the API clients of both combined providers.
+// Nil values fall back to the defaults.
+type HttpSettings struct {
+	MaxRetries        *int64
+	RetryMaxWait      *int64
+	RequestsPerMinute *int64
+}
+
+func (s HttpSettings) Validate() error {
+	if s.MaxRetries != nil && (*s.MaxRetries < 0 || *s.MaxRetries > zendesk_http.MaxRetriesLimit) {
+		return fmt.Errorf("max_retries must be between 0 and %d, got %d", zendesk_http.MaxRetriesLimit, *s.MaxRetries)
+	}
+	if s.RetryMaxWait != nil && *s.RetryMaxWait < 1 {
+		return fmt.Errorf("retry_max_wait must be at least 1 second, got %d", *s.RetryMaxWait)
+	}
+	if s.RequestsPerMinute != nil && *s.RequestsPerMinute < 1 {
+		return fmt.Errorf("requests_per_minute must be at least 1, got %d", *s.RequestsPerMinute)
+	}
+	return nil
+}
+
+var (
+	rateLimitersMutex sync.Mutex
+	rateLimiters      = make(map[string]*zendesk_http.RateLimiter)
+)
+
+// SharedRateLimiter returns the rate limiter for requests_per_minute, nil when it is not set.
+// The framework provider and the nukosuke provider are configured separately in the same plugin
+// process, both get the same limiter for the same API, so together they stay within one budget.
+func (s HttpSettings) SharedRateLimiter(serverUrl string) *zendesk_http.RateLimiter {
+	if s.RequestsPerMinute == nil {
+		return nil
+	}
+
+	rateLimitersMutex.Lock()
+	defer rateLimitersMutex.Unlock()
+
+	key := fmt.Sprintf("%s|%d", serverUrl, *s.RequestsPerMinute)
+	limiter, ok := rateLimiters[key]
+	if !ok {
+		limiter = zendesk_http.NewRateLimiter(*s.RequestsPerMinute)
+		rateLimiters[key] = limiter
+	}
+	return limiter
+}
+
+// NewHttpClient creates the HTTP client which throttles requests with the given limiter,
+// if any, and retries rate limited and failed requests. Every retry waits for the limiter again
+// and every attempt is logged.
+func (s HttpSettings) NewHttpClient(limiter *zendesk_http.RateLimiter) *http.Client {
+	maxRetries := zendesk_http.DefaultMaxRetries
+	if s.MaxRetries != nil {
+		maxRetries = int(*s.MaxRetries)
+	}
+	retryMaxWait := zendesk_http.DefaultRetryMaxWait
+	if s.RetryMaxWait != nil {
+		retryMaxWait = time.Duration(*s.RetryMaxWait) * time.Second
+	}
+
+	var transport http.RoundTripper = &zendesk_http.LoggingTransport{Base: http.DefaultTransport}
+	if limiter != nil {
+		transport = &zendesk_http.RateLimitTransport{Limiter: limiter, Base: transport}
+	}
+
+	return &http.Client{
+		Transport: zendesk_http.NewRetryTransport(maxRetries, retryMaxWait, transport),
+	}
+}
@@ -0,0 +1,29 @@
+package provider_config
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+	"terraform-provider-zendesk/zendesk_http"
+)
+
+func TestHttpSettings_SharedRateLimiter(t *testing.T) {
+	unlimited := HttpSettings{}
+	assert.Assert(t, unlimited.SharedRateLimiter("https://example.zendesk.com") == nil)
+
+	requestsPerMinute := int64(400)
+	settings := HttpSettings{RequestsPerMinute: &requestsPerMinute}
+	first := settings.SharedRateLimiter("https://example.zendesk.com")
+	assert.Assert(t, first != nil)
+	assert.Assert(t, first == settings.SharedRateLimiter("https://example.zendesk.com"), "both providers must share one budget")
+	assert.Assert(t, first != settings.SharedRateLimiter("https://other.zendesk.com"))
+}
+
+func TestHttpSettings_Validate(t *testing.T) {
+	negative, zero, tooMany := int64(-1), int64(0), int64(zendesk_http.MaxRetriesLimit+1)
+	assert.NilError(t, HttpSettings{MaxRetries: &zero}.Validate())
+	assert.ErrorContains(t, HttpSettings{MaxRetries: &negative}.Validate(), "max_retries")
+	assert.ErrorContains(t, HttpSettings{MaxRetries: &tooMany}.Validate(), "max_retries")
+	assert.ErrorContains(t, HttpSettings{RetryMaxWait: &zero}.Validate(), "retry_max_wait")
+	assert.ErrorContains(t, HttpSettings{RequestsPerMinute: &zero}.Validate(), "requests_per_minute")
+}
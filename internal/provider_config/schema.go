@@ -0,0 +1,21 @@
+package provider_config
+
+// The provider schema is served by both the plugin framework provider and the upgraded
+// nukosuke provider. terraform-plugin-mux requires both schemas to be identical, so the
+// attribute descriptions are shared from here.
+const (
+	AccountDescription             = "Account name of your Zendesk instance. Can also be set with the ZENDESK_ACCOUNT environment variable or in a credentials file profile."
+	ApiUrlDescription              = "Base URL of the Zendesk API, e.g. `https://support.example.com` for a host-mapped custom domain or `http://localhost:8080` for a mock server or recording proxy. The `/api/v2` paths are appended to it. Takes precedence over `account`. Can also be set with the ZENDESK_API_URL environment variable or in a credentials file profile."
+	EmailDescription               = "Email address of agent user who have permission to access the API. Can also be set with the ZENDESK_EMAIL environment variable or in a credentials file profile."
+	TokenDescription               = "[API token](https://developer.zendesk.com/rest_api/docs/support/introduction#api-token) for your Zendesk instance. Can also be set with the ZENDESK_TOKEN environment variable or in a credentials file profile."
+	OAuthTokenDescription          = "[OAuth access token](https://developer.zendesk.com/api-reference/introduction/security-and-auth/#bearer-token) used instead of email and API token. Can also be set with the ZENDESK_OAUTH_TOKEN environment variable or in a credentials file profile."
+	OAuthClientIdDescription       = "Identifier of a confidential OAuth client, exchanged together with `oauth_client_secret` for an access token with the client credentials grant. Can also be set with the ZENDESK_OAUTH_CLIENT_ID environment variable or in a credentials file profile."
+	OAuthSecretDescription         = "Secret of the OAuth client given in `oauth_client_id`. Can also be set with the ZENDESK_OAUTH_CLIENT_SECRET environment variable or in a credentials file profile."
+	OAuthScopeDescription          = "Space separated scopes requested in the client credentials exchange, e.g. `triggers:write webhooks:write`. Can also be set with the ZENDESK_OAUTH_SCOPE environment variable or in a credentials file profile. Defaults to `read write`."
+	MaxRetriesDescription          = "Number of times a request is retried when Zendesk rejects it with 429 Too Many Requests or, for idempotent requests, fails with a server error. `0` disables retries, at most `10`. Defaults to `3`."
+	RetryMaxWaitDescription        = "Maximum number of seconds to wait before a retry, also when the `Retry-After` header asks for longer. Defaults to `60`."
+	RequestsPerMinuteDescription   = "Maximum number of API requests per minute of all resources and data sources together, requests above it wait. Set it below the rate limit of your Zendesk plan to avoid 429 responses in large runs. Unlimited when not set."
+	ProfileDescription             = "Name of the profile in the credentials file to read credentials from. Can also be set with the ZENDESK_PROFILE environment variable. Defaults to `default`."
+	ValidateCredentialsDescription = "Check the account, the credentials and the admin role of the authenticated user with a request to `/api/v2/users/me` when the provider is configured, so wrong credentials fail before any resource is changed. Defaults to `true`."
+	CredentialsFileDescription     = "Path of an INI style credentials file with one `[profile]` section per account. Can also be set with the ZENDESK_CREDENTIALS_FILE environment variable. Defaults to `~/.zendesk/credentials`, which is ignored when it does not exist."
+)
@@ -2,12 +2,13 @@ package resource_webhook
 
 import (
 	"context"
-	"fmt"
+	"encoding/json"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"reflect"
 	"terraform-provider-zendesk/zendesk_webhook_api"
 )
 
@@ -74,6 +75,45 @@ func (m *WebhookMapper) MapToUpdateRequestBody(ctx context.Context, r *WebhookMo
 	return request, nil
 }
 
+// MapToPatchRequestBody computes the JSON merge patch (RFC 7386) from the webhook in the state to the planned webhook.
+// Unchanged attributes are left out, so the sensitive authentication data is only sent when it changed.
+func (m *WebhookMapper) MapToPatchRequestBody(ctx context.Context, state *WebhookModel, plan *WebhookModel) (map[string]interface{}, diag.Diagnostics) {
+	stateBody, diags := mapToMergePatchDocument(ctx, state)
+	if diags.HasError() {
+		return nil, diags
+	}
+	planBody, diags := mapToMergePatchDocument(ctx, plan)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	// Attributes computed by Zendesk are unknown in the plan and stay as they are
+	unknownInPlan := map[string]attr.Value{
+		"authentication":  plan.Webhook.Authentication,
+		"custom_headers":  plan.Webhook.CustomHeaders,
+		"external_source": plan.Webhook.ExternalSource,
+		"subscriptions":   plan.Webhook.Subscriptions,
+	}
+	for key, value := range unknownInPlan {
+		if value.IsUnknown() {
+			delete(stateBody, key)
+		}
+	}
+
+	patch := mergePatch(stateBody, planBody)
+
+	// Zendesk needs the type of the authentication to validate changed authentication data
+	if authentication, ok := patch["authentication"].(map[string]interface{}); ok {
+		plannedAuthentication := planBody["authentication"].(map[string]interface{})
+		authentication["type"] = plannedAuthentication["type"]
+		if addPosition, ok := plannedAuthentication["add_position"]; ok {
+			authentication["add_position"] = addPosition
+		}
+	}
+
+	return map[string]interface{}{"webhook": patch}, nil
+}
+
 func putWebhookResponseBodyToStateModel(ctx context.Context, webhookWithoutSensitive *zendesk_webhook_api.WebhookWithoutSensitive, webhookState *WebhookModel) diag.Diagnostics {
 
 	webhookState.WebhookId = types.StringValue(*webhookWithoutSensitive.Id)
@@ -215,7 +255,6 @@ func setAuthenticationFromResponseToState(ctx context.Context, webhookWithoutSen
 		tflog.Error(ctx, "Error reading authentication data from the API: ", map[string]interface{}{"error": diags})
 		return diags
 	}
-	tflog.Debug(ctx, fmt.Sprintf("Mapped Auth Data: %v", authDataMapped))
 
 	authData, diags := authDataMapped.ToObjectValue(ctx)
 	if diags.HasError() {
@@ -500,3 +539,49 @@ func stringValOrNull(value *string) basetypes.StringValue {
 
 	return types.StringValue(*value)
 }
+
+// mapToMergePatchDocument maps the webhook to the JSON document of the request body, without the signing secret
+// that is only changed by resetting it.
+func mapToMergePatchDocument(ctx context.Context, model *WebhookModel) (map[string]interface{}, diag.Diagnostics) {
+	webhookRequestBody := zendesk_webhook_api.WebhookWithSensitiveData{}
+	diags := mapPlanModelToWebhookRequestBody(ctx, model, &webhookRequestBody)
+	if diags.HasError() {
+		return nil, diags
+	}
+	webhookRequestBody.SigningSecret = nil
+
+	body, err := json.Marshal(webhookRequestBody)
+	if err != nil {
+		return nil, diag.Diagnostics{diag.NewErrorDiagnostic("Error mapping the webhook to a merge patch", err.Error())}
+	}
+	document := make(map[string]interface{})
+	if err := json.Unmarshal(body, &document); err != nil {
+		return nil, diag.Diagnostics{diag.NewErrorDiagnostic("Error mapping the webhook to a merge patch", err.Error())}
+	}
+	return document, nil
+}
+
+// mergePatch returns the JSON merge patch that changes the document from into the document to. Removed members
+// are set to null, changed objects are patched recursively and any other changed value is replaced.
+func mergePatch(from map[string]interface{}, to map[string]interface{}) map[string]interface{} {
+	patch := make(map[string]interface{})
+	for key, toValue := range to {
+		fromValue, exists := from[key]
+		if exists && reflect.DeepEqual(fromValue, toValue) {
+			continue
+		}
+		fromObject, fromIsObject := fromValue.(map[string]interface{})
+		toObject, toIsObject := toValue.(map[string]interface{})
+		if fromIsObject && toIsObject {
+			patch[key] = mergePatch(fromObject, toObject)
+			continue
+		}
+		patch[key] = toValue
+	}
+	for key := range from {
+		if _, exists := to[key]; !exists {
+			patch[key] = nil
+		}
+	}
+	return patch
+}
@@ -208,6 +208,72 @@ func TestWebhookMapper_MapToUpdateRequestBody(t *testing.T) {
 	}
 }
 
+func TestWebhookMapper_MapToPatchRequestBody(t *testing.T) {
+	tests := []struct {
+		name      string
+		plan      func(model *WebhookModel)
+		wantPatch string
+	}{
+		{name: "unchanged webhook",
+			plan:      func(model *WebhookModel) {},
+			wantPatch: `{"webhook":{}}`},
+		{name: "changed and removed attributes",
+			plan: func(model *WebhookModel) {
+				model.Webhook.Name = types.StringValue("renamed-webhook")
+				model.Webhook.Description = types.StringNull()
+				model.Webhook.CustomHeaders, _ = types.MapValue(types.StringType, map[string]attr.Value{"header1": types.StringValue("changed")})
+			},
+			wantPatch: `{"webhook":{"custom_headers":{"header1":"changed","header2":null},"description":null,"name":"renamed-webhook"}}`},
+		{name: "changed password is sent with the authentication type",
+			plan: func(model *WebhookModel) {
+				auth := NewAuthenticationValueNull()
+				auth.AuthenticationType = types.StringValue("basic_auth")
+				dataValue := NewDataValueNull()
+				dataValue.Username = types.StringValue("test-user")
+				dataValue.Password = types.StringValue("new-word")
+				auth.Data, _ = dataValue.ToObjectValue(context.Background())
+				auth.AddPosition = types.StringValue("header")
+				model.Webhook.Authentication, _ = auth.ToObjectValue(context.Background())
+			},
+			wantPatch: `{"webhook":{"authentication":{"add_position":"header","data":{"password":"new-word"},"type":"basic_auth"}}}`},
+		{name: "unknown computed attributes are left out",
+			plan: func(model *WebhookModel) {
+				model.Webhook.Subscriptions = types.ListUnknown(types.StringType)
+				model.Webhook.SigningSecret = types.ObjectUnknown(SigningSecretValue{}.AttributeTypes(context.Background()))
+			},
+			wantPatch: `{"webhook":{}}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := getCreateWebhookModel()
+			tt.plan(plan)
+
+			m := &WebhookMapper{}
+			gotPatch, gotDiagnostics := m.MapToPatchRequestBody(context.Background(), getCreateWebhookModel(), plan)
+
+			assert.Assert(t, !gotDiagnostics.HasError(), gotDiagnostics)
+			body, err := json.Marshal(gotPatch)
+			assert.NilError(t, err)
+			assert.Equal(t, string(body), tt.wantPatch)
+		})
+	}
+}
+
+func TestWebhookMapper_MapToPatchRequestBodyOfClone(t *testing.T) {
+	plan := getCreateWebhookModel()
+	plan.Webhook.Authentication = types.ObjectNull(AuthenticationValue{}.AttributeTypes(context.Background()))
+	plan.Webhook.ExternalSource = types.ObjectNull(ExternalSourceValue{}.AttributeTypes(context.Background()))
+
+	m := &WebhookMapper{}
+	gotPatch, gotDiagnostics := m.MapToPatchRequestBody(context.Background(), &WebhookModel{}, plan)
+
+	assert.Assert(t, !gotDiagnostics.HasError(), gotDiagnostics)
+	body, err := json.Marshal(gotPatch)
+	assert.NilError(t, err)
+	assert.Equal(t, string(body), `{"webhook":{"custom_headers":{"header1":"value1","header2":"value2"},"description":"test webhook",`+
+		`"endpoint":"https://example.com","http_method":"POST","name":"test-webhook","request_format":"json","status":"active","subscriptions":["subscription1"]}}`)
+}
+
 func getUpdateRequestBodyOnlyAuthenticationAttributes() zendesk_webhook_api.UpdateWebhookJSONRequestBody {
 	password := "test-word2"
 	username := "test-user2"
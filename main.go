@@ -44,13 +44,16 @@ func main() {
 		serveOpts = append(serveOpts, tf6server.WithManagedDebug())
 	}
 
+	// build the server before serving, so a broken provider fails with a readable error
+	// instead of a crashed plugin
+	muxServer, err := combined_provider.BuildMuxProviderServer(provider.New(version)())
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	err = tf6server.Serve(
 		"registry.terraform.io/andsafe-AG/zendesk",
 		func() tfprotov6.ProviderServer {
-			muxServer, err2 := combined_provider.BuildMuxProviderServer(provider.New(version)())
-			if err2 != nil {
-				log.Fatal(err2)
-			}
 			return *muxServer
 		},
 		serveOpts...,
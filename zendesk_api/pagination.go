@@ -0,0 +1,152 @@
+package zendesk_api
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"terraform-provider-zendesk/zendesk_http"
+)
+
+// Paginate reads the pages of a list and yields their items, until yield returns false or the last page was read.
+// listPage requests one page with the given request editors and returns its items and pagination links.
+func Paginate[T any](ctx context.Context, listPage func(ctx context.Context, reqEditors ...RequestEditorFn) (zendesk_http.Page[T], error), yield func(T) bool) error {
+	return zendesk_http.Paginate(ctx, func(ctx context.Context, next *url.URL) (zendesk_http.Page[T], error) {
+		return listPage(ctx, RequestEditorFn(zendesk_http.FollowPage(next)))
+	}, yield)
+}
+
+// ForEachCustomStatus yields the custom ticket statuses matching the params, until yield returns false.
+func (s *SupportApi) ForEachCustomStatus(ctx context.Context, params *ListCustomStatusesParams, yield func(CustomStatusObject) bool) error {
+	return Paginate(ctx, func(ctx context.Context, reqEditors ...RequestEditorFn) (zendesk_http.Page[CustomStatusObject], error) {
+		var page zendesk_http.Page[CustomStatusObject]
+		response, err := s.supportApiClient.ListCustomStatusesWithResponse(ctx, params, reqEditors...)
+		if err != nil {
+			return page, fmt.Errorf("listing custom statuses: %w", err)
+		}
+		if response.StatusCode() != 200 || response.JSON200 == nil {
+			return page, fmt.Errorf("listing custom statuses, StatusCode: %v: %s", response.StatusCode(), string(response.Body))
+		}
+
+		// The custom statuses are not paginated, the response has all of them
+		if response.JSON200.CustomStatuses != nil {
+			page.Items = *response.JSON200.CustomStatuses
+		}
+		return page, nil
+	}, yield)
+}
+
+// ForEachTriggerCategory yields the trigger categories matching the params from all pages, until yield returns false.
+func (s *SupportApi) ForEachTriggerCategory(ctx context.Context, params *ListTriggerCategoriesParams, yield func(TriggerCategory) bool) error {
+	return Paginate(ctx, func(ctx context.Context, reqEditors ...RequestEditorFn) (zendesk_http.Page[TriggerCategory], error) {
+		var page zendesk_http.Page[TriggerCategory]
+		response, err := s.supportApiClient.ListTriggerCategoriesWithResponse(ctx, params, reqEditors...)
+		if err != nil {
+			return page, fmt.Errorf("listing trigger categories: %w", err)
+		}
+		if response.StatusCode() != 200 || response.JSON200 == nil {
+			return page, fmt.Errorf("listing trigger categories, StatusCode: %v: %s", response.StatusCode(), string(response.Body))
+		}
+
+		if response.JSON200.TriggerCategories != nil {
+			page.Items = *response.JSON200.TriggerCategories
+		}
+		if response.JSON200.Meta != nil {
+			page.Links.HasMore = response.JSON200.Meta.HasMore
+			page.Links.AfterCursor = response.JSON200.Meta.AfterCursor
+		}
+		if response.JSON200.Links != nil {
+			page.Links.Next = response.JSON200.Links.Next
+		}
+		return page, nil
+	}, yield)
+}
+
+// ForEachTrigger yields the triggers matching the params from all pages, until yield returns false.
+func (s *SupportApi) ForEachTrigger(ctx context.Context, params *ListTriggersParams, yield func(TriggerObject) bool) error {
+	return Paginate(ctx, func(ctx context.Context, reqEditors ...RequestEditorFn) (zendesk_http.Page[TriggerObject], error) {
+		var page zendesk_http.Page[TriggerObject]
+		response, err := s.supportApiClient.ListTriggersWithResponse(ctx, params, reqEditors...)
+		if err != nil {
+			return page, fmt.Errorf("listing triggers: %w", err)
+		}
+		if response.StatusCode() != 200 || response.JSON200 == nil {
+			return page, fmt.Errorf("listing triggers, StatusCode: %v: %s", response.StatusCode(), string(response.Body))
+		}
+
+		if response.JSON200.Triggers != nil {
+			page.Items = *response.JSON200.Triggers
+		}
+		page.Links.NextPage = response.JSON200.NextPage
+		return page, nil
+	}, yield)
+}
+
+// ForEachSLAPolicy yields the SLA policies from all pages, until yield returns false.
+func (s *SupportApi) ForEachSLAPolicy(ctx context.Context, yield func(SLAPolicyObject) bool) error {
+	return Paginate(ctx, func(ctx context.Context, reqEditors ...RequestEditorFn) (zendesk_http.Page[SLAPolicyObject], error) {
+		var page zendesk_http.Page[SLAPolicyObject]
+		response, err := s.supportApiClient.ListSLAPoliciesWithResponse(ctx, reqEditors...)
+		if err != nil {
+			return page, fmt.Errorf("listing SLA policies: %w", err)
+		}
+		if response.StatusCode() != 200 || response.JSON200 == nil {
+			return page, fmt.Errorf("listing SLA policies, StatusCode: %v: %s", response.StatusCode(), string(response.Body))
+		}
+
+		if response.JSON200.SlaPolicies != nil {
+			page.Items = *response.JSON200.SlaPolicies
+		}
+		page.Links.NextPage = response.JSON200.NextPage
+		return page, nil
+	}, yield)
+}
+
+// ForEachTriggerRevision yields the revisions of a trigger from all pages, the newest first, until yield returns false.
+func (s *SupportApi) ForEachTriggerRevision(ctx context.Context, triggerId TriggerId, yield func(TriggerRevisionObject) bool) error {
+	return Paginate(ctx, func(ctx context.Context, reqEditors ...RequestEditorFn) (zendesk_http.Page[TriggerRevisionObject], error) {
+		var page zendesk_http.Page[TriggerRevisionObject]
+		response, err := s.supportApiClient.ListTriggerRevisionsWithResponse(ctx, triggerId, reqEditors...)
+		if err != nil {
+			return page, fmt.Errorf("listing trigger revisions: %w", err)
+		}
+		if response.StatusCode() != 200 || response.JSON200 == nil {
+			return page, fmt.Errorf("listing trigger revisions, StatusCode: %v: %s", response.StatusCode(), string(response.Body))
+		}
+
+		if response.JSON200.TriggerRevisions != nil {
+			page.Items = *response.JSON200.TriggerRevisions
+		}
+		// the revisions are sorted newest first, the before link leads to the older ones until a page is empty
+		hasMore := len(page.Items) > 0 && response.JSON200.BeforeUrl != nil && *response.JSON200.BeforeUrl != ""
+		page.Links.HasMore = &hasMore
+		page.Links.Next = response.JSON200.BeforeUrl
+		return page, nil
+	}, yield)
+}
+
+// ForEachViewTicket yields the tickets of a view from all pages, until yield returns false.
+func (s *SupportApi) ForEachViewTicket(ctx context.Context, viewId ViewId, params *ListTicketsFromViewParams, yield func(TicketObject) bool) error {
+	return Paginate(ctx, func(ctx context.Context, reqEditors ...RequestEditorFn) (zendesk_http.Page[TicketObject], error) {
+		var page zendesk_http.Page[TicketObject]
+		response, err := s.supportApiClient.ListTicketsFromViewWithResponse(ctx, viewId, params, reqEditors...)
+		if err != nil {
+			return page, fmt.Errorf("listing the tickets of view %d: %w", viewId, err)
+		}
+		if response.StatusCode() != 200 || response.JSON200 == nil {
+			return page, fmt.Errorf("listing the tickets of view %d, StatusCode: %v: %s", viewId, response.StatusCode(), string(response.Body))
+		}
+
+		if response.JSON200.Tickets != nil {
+			page.Items = *response.JSON200.Tickets
+		}
+		if response.JSON200.Meta != nil {
+			page.Links.HasMore = response.JSON200.Meta.HasMore
+			page.Links.AfterCursor = response.JSON200.Meta.AfterCursor
+		}
+		if response.JSON200.Links != nil {
+			page.Links.Next = response.JSON200.Links.Next
+		}
+		return page, nil
+	}, yield)
+}
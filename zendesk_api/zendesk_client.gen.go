@@ -3418,6 +3418,12 @@ type SLAPolicyObject struct {
 	Url *string `json:"url,omitempty"`
 }
 
+// SLAPolicyReorderRequest defines model for SLAPolicyReorderRequest.
+type SLAPolicyReorderRequest struct {
+	// SlaPolicyIds The ids of the SLA policies, in the order they are applied
+	SlaPolicyIds []int `json:"sla_policy_ids"`
+}
+
 // SLAPolicyResponse defines model for SLAPolicyResponse.
 type SLAPolicyResponse struct {
 	SlaPolicy *SLAPolicyObject `json:"sla_policy,omitempty"`
@@ -5615,64 +5621,59 @@ type TriggerObject struct {
 	Url *string `json:"url,omitempty"`
 }
 
+// TriggerReorderRequest defines model for TriggerReorderRequest.
+type TriggerReorderRequest struct {
+	// TriggerIds The ids of all triggers of the account, in their firing order
+	TriggerIds []int `json:"trigger_ids"`
+}
+
 // TriggerResponse defines model for TriggerResponse.
 type TriggerResponse struct {
 	Trigger *TriggerObject `json:"trigger,omitempty"`
 }
 
-// TriggerRevisionResponse defines model for TriggerRevisionResponse.
-type TriggerRevisionResponse struct {
-	TriggerRevision *struct {
-		AuthorId  *int    `json:"author_id,omitempty"`
-		CreatedAt *string `json:"created_at,omitempty"`
-		Id        *int    `json:"id,omitempty"`
-		Snapshot  *struct {
-			Actions *[]TriggerActionObject `json:"actions,omitempty"`
-			Active  *bool                  `json:"active,omitempty"`
-
-			// Conditions An object that describes the conditions under which the trigger will execute. See [Conditions reference](/documentation/ticketing/reference-guides/conditions-reference)
-			Conditions  *TriggerConditionsObject `json:"conditions,omitempty"`
-			Description *string                  `json:"description"`
-			Title       *string                  `json:"title,omitempty"`
-		} `json:"snapshot,omitempty"`
-		Url *string `json:"url,omitempty"`
-	} `json:"trigger_revision,omitempty"`
-}
+// TriggerRevisionObject defines model for TriggerRevisionObject.
+type TriggerRevisionObject struct {
+	AuthorId  *int    `json:"author_id,omitempty"`
+	CreatedAt *string `json:"created_at,omitempty"`
+	Diff      *struct {
+		// Actions An array that contain [action diff objects](#Action Diffs)
+		Actions *[]TriggerActionDiffObject `json:"actions,omitempty"`
 
-// TriggerRevisionsResponse defines model for TriggerRevisionsResponse.
-type TriggerRevisionsResponse struct {
-	AfterCursor      *string `json:"after_cursor,omitempty"`
-	AfterUrl         *string `json:"after_url,omitempty"`
-	BeforeCursor     *string `json:"before_cursor,omitempty"`
-	BeforeUrl        *string `json:"before_url,omitempty"`
-	Count            *int    `json:"count,omitempty"`
-	TriggerRevisions *[]struct {
-		AuthorId  *int    `json:"author_id,omitempty"`
-		CreatedAt *string `json:"created_at,omitempty"`
-		Diff      *struct {
-			// Actions An array that contain [action diff objects](#Action Diffs)
-			Actions *[]TriggerActionDiffObject `json:"actions,omitempty"`
+		// Active An array of [change](#change) objects
+		Active     *[]TriggerChangeObject      `json:"active,omitempty"`
+		Conditions *TriggerConditionDiffObject `json:"conditions,omitempty"`
 
-			// Active An array of [change](#change) objects
-			Active     *[]TriggerChangeObject      `json:"active,omitempty"`
-			Conditions *TriggerConditionDiffObject `json:"conditions,omitempty"`
+		// Description An array of [change](#change) objects
+		Description *[]TriggerChangeObject `json:"description,omitempty"`
 
-			// Description An array of [change](#change) objects
-			Description *[]TriggerChangeObject `json:"description,omitempty"`
+		// SourceId ID of the source revision
+		SourceId *int `json:"source_id,omitempty"`
 
-			// SourceId ID of the source revision
-			SourceId *int `json:"source_id,omitempty"`
+		// TargetId ID of the target revision
+		TargetId *int `json:"target_id,omitempty"`
 
-			// TargetId ID of the target revision
-			TargetId *int `json:"target_id,omitempty"`
+		// Title An array of [change](#change) objects
+		Title *[]TriggerChangeObject `json:"title,omitempty"`
+	} `json:"diff,omitempty"`
+	Id       *int                   `json:"id,omitempty"`
+	Snapshot *TriggerSnapshotObject `json:"snapshot,omitempty"`
+	Url      *string                `json:"url,omitempty"`
+}
+
+// TriggerRevisionResponse defines model for TriggerRevisionResponse.
+type TriggerRevisionResponse struct {
+	TriggerRevision *TriggerRevisionObject `json:"trigger_revision,omitempty"`
+}
 
-			// Title An array of [change](#change) objects
-			Title *[]TriggerChangeObject `json:"title,omitempty"`
-		} `json:"diff,omitempty"`
-		Id       *int                   `json:"id,omitempty"`
-		Snapshot *TriggerSnapshotObject `json:"snapshot,omitempty"`
-		Url      *string                `json:"url,omitempty"`
-	} `json:"trigger_revisions,omitempty"`
+// TriggerRevisionsResponse defines model for TriggerRevisionsResponse.
+type TriggerRevisionsResponse struct {
+	AfterCursor      *string                  `json:"after_cursor,omitempty"`
+	AfterUrl         *string                  `json:"after_url,omitempty"`
+	BeforeCursor     *string                  `json:"before_cursor,omitempty"`
+	BeforeUrl        *string                  `json:"before_url,omitempty"`
+	Count            *int                     `json:"count,omitempty"`
+	TriggerRevisions *[]TriggerRevisionObject `json:"trigger_revisions,omitempty"`
 }
 
 // TriggerSnapshotObject defines model for TriggerSnapshotObject.
@@ -6173,6 +6174,36 @@ type ViaObject_Source struct {
 	AdditionalProperties map[string]interface{} `json:"-"`
 }
 
+// ViewColumnObject defines model for ViewColumnObject.
+type ViewColumnObject struct {
+	// Id The name of a system column, or the numeric id of a custom field
+	Id *interface{} `json:"id,omitempty"`
+
+	// Title The title of the column
+	Title *string `json:"title,omitempty"`
+}
+
+// ViewConditionObject defines model for ViewConditionObject.
+type ViewConditionObject struct {
+	// Field The name of a ticket field
+	Field *string `json:"field,omitempty"`
+
+	// Operator A comparison operator
+	Operator *string `json:"operator,omitempty"`
+
+	// Value The value of a ticket field, a string, a number or a list of them
+	Value *interface{} `json:"value,omitempty"`
+}
+
+// ViewConditionsObject Describes how the view is constructed. See [Conditions reference](/documentation/ticketing/reference-guides/conditions-reference)
+type ViewConditionsObject struct {
+	// All Logical AND. Tickets must fulfill all of the conditions to be considered matching
+	All *[]ViewConditionObject `json:"all,omitempty"`
+
+	// Any Logical OR. Tickets may satisfy any of the conditions to be considered matching
+	Any *[]ViewConditionObject `json:"any,omitempty"`
+}
+
 // ViewCountObject defines model for ViewCountObject.
 type ViewCountObject struct {
 	// Active Only active views if true, inactive views if false, all views if null.
@@ -6204,6 +6235,38 @@ type ViewCountsResponse struct {
 	ViewCounts *[]ViewCountObject `json:"view_counts,omitempty"`
 }
 
+// ViewExecutionObject Describes how the view should be executed. See [Execution](#execution)
+type ViewExecutionObject struct {
+	// Columns The columns of the view
+	Columns *[]ViewColumnObject       `json:"columns,omitempty"`
+	Group   *ViewExecutionOrderObject `json:"group,omitempty"`
+
+	// GroupBy The column the tickets are grouped by
+	GroupBy *string `json:"group_by"`
+
+	// GroupOrder The direction the tickets are grouped, "asc" or "desc"
+	GroupOrder *string                   `json:"group_order,omitempty"`
+	Sort       *ViewExecutionOrderObject `json:"sort,omitempty"`
+
+	// SortBy The column the tickets are sorted by
+	SortBy *string `json:"sort_by"`
+
+	// SortOrder The direction the tickets are sorted, "asc" or "desc"
+	SortOrder *string `json:"sort_order,omitempty"`
+}
+
+// ViewExecutionOrderObject defines model for ViewExecutionOrderObject.
+type ViewExecutionOrderObject struct {
+	// Id The name of a system column, or the numeric id of a custom field
+	Id *interface{} `json:"id,omitempty"`
+
+	// Order The direction, "asc" or "desc"
+	Order *string `json:"order,omitempty"`
+
+	// Title The title of the column
+	Title *string `json:"title,omitempty"`
+}
+
 // ViewExportResponse defines model for ViewExportResponse.
 type ViewExportResponse struct {
 	Export *struct {
@@ -6212,13 +6275,40 @@ type ViewExportResponse struct {
 	} `json:"export,omitempty"`
 }
 
+// ViewInput The view to create, update or preview
+type ViewInput struct {
+	// Active Whether the view is active
+	Active *bool `json:"active,omitempty"`
+
+	// All Logical AND. Tickets must fulfill all of the conditions to be considered matching
+	All *[]ViewConditionObject `json:"all,omitempty"`
+
+	// Any Logical OR. Tickets may satisfy any of the conditions to be considered matching
+	Any *[]ViewConditionObject `json:"any,omitempty"`
+
+	// Description The description of the view
+	Description *string `json:"description,omitempty"`
+
+	// Output The columns, grouping and sorting of the view. See [View columns](#view-columns)
+	Output *ViewOutputObject `json:"output,omitempty"`
+
+	// Position The position of the view
+	Position *int `json:"position,omitempty"`
+
+	// Restriction Who may access the view. Is null when everyone in the account can access it
+	Restriction *ViewRestrictionObject `json:"restriction"`
+
+	// Title The title of the view
+	Title *string `json:"title,omitempty"`
+}
+
 // ViewObject defines model for ViewObject.
 type ViewObject struct {
 	// Active Whether the view is active
 	Active *bool `json:"active,omitempty"`
 
 	// Conditions Describes how the view is constructed. See [Conditions reference](/documentation/ticketing/reference-guides/conditions-reference)
-	Conditions *map[string]interface{} `json:"conditions,omitempty"`
+	Conditions *ViewConditionsObject `json:"conditions,omitempty"`
 
 	// CreatedAt The time the view was created
 	CreatedAt *time.Time `json:"created_at,omitempty"`
@@ -6230,7 +6320,7 @@ type ViewObject struct {
 	Description *string `json:"description,omitempty"`
 
 	// Execution Describes how the view should be executed. See [Execution](#execution)
-	Execution *map[string]interface{} `json:"execution,omitempty"`
+	Execution *ViewExecutionObject `json:"execution,omitempty"`
 
 	// Id Automatically assigned when created
 	Id *int `json:"id,omitempty"`
@@ -6238,8 +6328,8 @@ type ViewObject struct {
 	// Position The position of the view
 	Position *int `json:"position,omitempty"`
 
-	// Restriction Who may access this account. Is null when everyone in the account can access it
-	Restriction *map[string]interface{} `json:"restriction,omitempty"`
+	// Restriction Who may access the view. Is null when everyone in the account can access it
+	Restriction *ViewRestrictionObject `json:"restriction"`
 
 	// Title The title of the view
 	Title *string `json:"title,omitempty"`
@@ -6248,6 +6338,30 @@ type ViewObject struct {
 	UpdatedAt *time.Time `json:"updated_at,omitempty"`
 }
 
+// ViewOutputObject The columns, grouping and sorting of the view. See [View columns](#view-columns)
+type ViewOutputObject struct {
+	// Columns The columns to display, system columns by name and custom fields by id
+	Columns *[]interface{} `json:"columns,omitempty"`
+
+	// GroupBy The column the tickets are grouped by
+	GroupBy *string `json:"group_by,omitempty"`
+
+	// GroupOrder The direction the tickets are grouped, "asc" or "desc"
+	GroupOrder *string `json:"group_order,omitempty"`
+
+	// SortBy The column the tickets are sorted by
+	SortBy *string `json:"sort_by,omitempty"`
+
+	// SortOrder The direction the tickets are sorted, "asc" or "desc"
+	SortOrder *string `json:"sort_order,omitempty"`
+}
+
+// ViewRequest defines model for ViewRequest.
+type ViewRequest struct {
+	// View The view to create, update or preview
+	View ViewInput `json:"view"`
+}
+
 // ViewResponse defines model for ViewResponse.
 type ViewResponse struct {
 	Columns *[]map[string]interface{} `json:"columns,omitempty"`
@@ -6256,6 +6370,18 @@ type ViewResponse struct {
 	View    *ViewObject               `json:"view,omitempty"`
 }
 
+// ViewRestrictionObject Who may access the view. Is null when everyone in the account can access it
+type ViewRestrictionObject struct {
+	// Id The id of a single group or user
+	Id *int `json:"id,omitempty"`
+
+	// Ids The ids of the groups
+	Ids *[]int `json:"ids,omitempty"`
+
+	// Type Allowed values are "Group" or "User"
+	Type *string `json:"type,omitempty"`
+}
+
 // ViewsCountResponse defines model for ViewsCountResponse.
 type ViewsCountResponse struct {
 	Count *struct {
@@ -7268,12 +7394,6 @@ type ExportSearchResultsParams struct {
 	FilterType *string `form:"filter[type],omitempty" json:"filter[type],omitempty"`
 }
 
-// ReorderSLAPoliciesParams defines parameters for ReorderSLAPolicies.
-type ReorderSLAPoliciesParams struct {
-	// SlaPolicyIds The IDs of the SLA Policies to reorder
-	SlaPolicyIds *[]int `form:"sla_policy_ids,omitempty" json:"sla_policy_ids,omitempty"`
-}
-
 // ListSuspendedTicketsParams defines parameters for ListSuspendedTickets.
 type ListSuspendedTicketsParams struct {
 	// SortBy The field to sort the ticket by, being one of `author_email`, `cause`, `created_at`, or `subject`.
@@ -7713,6 +7833,9 @@ type ListTicketsFromViewParams struct {
 
 	// SortOrder One of "asc" or "desc". Defaults to "asc" for alphabetical and position sort, "desc" for all others
 	SortOrder *string `form:"sort_order,omitempty" json:"sort_order,omitempty"`
+
+	// PageSize Specifies how many records should be returned in the response. You can specify up to 100 records per page.
+	PageSize *int `form:"page[size],omitempty" json:"page[size],omitempty"`
 }
 
 // CreateWorkspaceJSONBody defines parameters for CreateWorkspace.
@@ -7805,6 +7928,9 @@ type AutocompleteProblemsJSONRequestBody AutocompleteProblemsJSONBody
 // PushNotificationDevicesJSONRequestBody defines body for PushNotificationDevices for application/json ContentType.
 type PushNotificationDevicesJSONRequestBody = PushNotificationDevicesRequest
 
+// ReorderSLAPoliciesJSONRequestBody defines body for ReorderSLAPolicies for application/json ContentType.
+type ReorderSLAPoliciesJSONRequestBody = SLAPolicyReorderRequest
+
 // CreateTicketJSONRequestBody defines body for CreateTicket for application/json ContentType.
 type CreateTicketJSONRequestBody = TicketCreateRequest
 
@@ -7829,6 +7955,9 @@ type UpdateTriggerCategoryJSONRequestBody UpdateTriggerCategoryJSONBody
 // CreateTriggerJSONRequestBody defines body for CreateTrigger for application/json ContentType.
 type CreateTriggerJSONRequestBody = TriggerWithCategoryRequest
 
+// ReorderTriggersJSONRequestBody defines body for ReorderTriggers for application/json ContentType.
+type ReorderTriggersJSONRequestBody = TriggerReorderRequest
+
 // UpdateManyTriggersJSONRequestBody defines body for UpdateManyTriggers for application/json ContentType.
 type UpdateManyTriggersJSONRequestBody = TriggerBulkUpdateRequest
 
@@ -7859,6 +7988,18 @@ type UpdateUserJSONRequestBody = UserRequest
 // MergeEndUsersJSONRequestBody defines body for MergeEndUsers for application/json ContentType.
 type MergeEndUsersJSONRequestBody = UserRequest
 
+// CreateViewJSONRequestBody defines body for CreateView for application/json ContentType.
+type CreateViewJSONRequestBody = ViewRequest
+
+// PreviewViewsJSONRequestBody defines body for PreviewViews for application/json ContentType.
+type PreviewViewsJSONRequestBody = ViewRequest
+
+// PreviewCountJSONRequestBody defines body for PreviewCount for application/json ContentType.
+type PreviewCountJSONRequestBody = ViewRequest
+
+// UpdateViewJSONRequestBody defines body for UpdateView for application/json ContentType.
+type UpdateViewJSONRequestBody = ViewRequest
+
 // CreateWorkspaceJSONRequestBody defines body for CreateWorkspace for application/json ContentType.
 type CreateWorkspaceJSONRequestBody CreateWorkspaceJSONBody
 
@@ -10494,8 +10635,10 @@ type ClientInterface interface {
 	// RetrieveSLAPolicyFilterDefinitionItems request
 	RetrieveSLAPolicyFilterDefinitionItems(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// ReorderSLAPolicies request
-	ReorderSLAPolicies(ctx context.Context, params *ReorderSLAPoliciesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// ReorderSLAPoliciesWithBody request with any body
+	ReorderSLAPoliciesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ReorderSLAPolicies(ctx context.Context, body ReorderSLAPoliciesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// DeleteSLAPolicy request
 	DeleteSLAPolicy(ctx context.Context, slaPolicyId SLAPolicyId, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -10768,8 +10911,10 @@ type ClientInterface interface {
 	// DeleteManyTriggers request
 	DeleteManyTriggers(ctx context.Context, params *DeleteManyTriggersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// ReorderTriggers request
-	ReorderTriggers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// ReorderTriggersWithBody request with any body
+	ReorderTriggersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ReorderTriggers(ctx context.Context, body ReorderTriggersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// SearchTriggers request
 	SearchTriggers(ctx context.Context, params *SearchTriggersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -10977,8 +11122,10 @@ type ClientInterface interface {
 	// ListViews request
 	ListViews(ctx context.Context, params *ListViewsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// CreateView request
-	CreateView(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// CreateViewWithBody request with any body
+	CreateViewWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateView(ctx context.Context, body CreateViewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// ListActiveViews request
 	ListActiveViews(ctx context.Context, params *ListActiveViewsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -10995,11 +11142,15 @@ type ClientInterface interface {
 	// BulkDeleteViews request
 	BulkDeleteViews(ctx context.Context, params *BulkDeleteViewsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// PreviewViews request
-	PreviewViews(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// PreviewViewsWithBody request with any body
+	PreviewViewsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PreviewViews(ctx context.Context, body PreviewViewsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PreviewCountWithBody request with any body
+	PreviewCountWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// PreviewCount request
-	PreviewCount(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	PreviewCount(ctx context.Context, body PreviewCountJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// SearchViews request
 	SearchViews(ctx context.Context, params *SearchViewsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -11016,8 +11167,10 @@ type ClientInterface interface {
 	// ShowView request
 	ShowView(ctx context.Context, viewId ViewId, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// UpdateView request
-	UpdateView(ctx context.Context, viewId ViewId, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// UpdateViewWithBody request with any body
+	UpdateViewWithBody(ctx context.Context, viewId ViewId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateView(ctx context.Context, viewId ViewId, body UpdateViewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetViewCount request
 	GetViewCount(ctx context.Context, viewId ViewId, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -14518,8 +14671,20 @@ func (c *Client) RetrieveSLAPolicyFilterDefinitionItems(ctx context.Context, req
 	return c.Client.Do(req)
 }
 
-func (c *Client) ReorderSLAPolicies(ctx context.Context, params *ReorderSLAPoliciesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewReorderSLAPoliciesRequest(c.Server, params)
+func (c *Client) ReorderSLAPoliciesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReorderSLAPoliciesRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReorderSLAPolicies(ctx context.Context, body ReorderSLAPoliciesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReorderSLAPoliciesRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -15646,8 +15811,20 @@ func (c *Client) DeleteManyTriggers(ctx context.Context, params *DeleteManyTrigg
 	return c.Client.Do(req)
 }
 
-func (c *Client) ReorderTriggers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewReorderTriggersRequest(c.Server)
+func (c *Client) ReorderTriggersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReorderTriggersRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReorderTriggers(ctx context.Context, body ReorderTriggersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReorderTriggersRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -16522,8 +16699,20 @@ func (c *Client) ListViews(ctx context.Context, params *ListViewsParams, reqEdit
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateView(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateViewRequest(c.Server)
+func (c *Client) CreateViewWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateViewRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateView(ctx context.Context, body CreateViewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateViewRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -16594,8 +16783,8 @@ func (c *Client) BulkDeleteViews(ctx context.Context, params *BulkDeleteViewsPar
 	return c.Client.Do(req)
 }
 
-func (c *Client) PreviewViews(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPreviewViewsRequest(c.Server)
+func (c *Client) PreviewViewsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPreviewViewsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -16606,8 +16795,32 @@ func (c *Client) PreviewViews(ctx context.Context, reqEditors ...RequestEditorFn
 	return c.Client.Do(req)
 }
 
-func (c *Client) PreviewCount(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPreviewCountRequest(c.Server)
+func (c *Client) PreviewViews(ctx context.Context, body PreviewViewsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPreviewViewsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PreviewCountWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPreviewCountRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PreviewCount(ctx context.Context, body PreviewCountJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPreviewCountRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -16678,8 +16891,20 @@ func (c *Client) ShowView(ctx context.Context, viewId ViewId, reqEditors ...Requ
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateView(ctx context.Context, viewId ViewId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateViewRequest(c.Server, viewId)
+func (c *Client) UpdateViewWithBody(ctx context.Context, viewId ViewId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateViewRequestWithBody(c.Server, viewId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateView(ctx context.Context, viewId ViewId, body UpdateViewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateViewRequest(c.Server, viewId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -27598,8 +27823,19 @@ func NewRetrieveSLAPolicyFilterDefinitionItemsRequest(server string) (*http.Requ
 	return req, nil
 }
 
-// NewReorderSLAPoliciesRequest generates requests for ReorderSLAPolicies
-func NewReorderSLAPoliciesRequest(server string, params *ReorderSLAPoliciesParams) (*http.Request, error) {
+// NewReorderSLAPoliciesRequest calls the generic ReorderSLAPolicies builder with application/json body
+func NewReorderSLAPoliciesRequest(server string, body ReorderSLAPoliciesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewReorderSLAPoliciesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewReorderSLAPoliciesRequestWithBody generates requests for ReorderSLAPolicies with any type of body
+func NewReorderSLAPoliciesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -27617,33 +27853,13 @@ func NewReorderSLAPoliciesRequest(server string, params *ReorderSLAPoliciesParam
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.SlaPolicyIds != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sla_policy_ids", runtime.ParamLocationQuery, *params.SlaPolicyIds); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
@@ -31175,8 +31391,19 @@ func NewDeleteManyTriggersRequest(server string, params *DeleteManyTriggersParam
 	return req, nil
 }
 
-// NewReorderTriggersRequest generates requests for ReorderTriggers
-func NewReorderTriggersRequest(server string) (*http.Request, error) {
+// NewReorderTriggersRequest calls the generic ReorderTriggers builder with application/json body
+func NewReorderTriggersRequest(server string, body ReorderTriggersJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewReorderTriggersRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewReorderTriggersRequestWithBody generates requests for ReorderTriggers with any type of body
+func NewReorderTriggersRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -31194,11 +31421,13 @@ func NewReorderTriggersRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
@@ -33947,8 +34176,19 @@ func NewListViewsRequest(server string, params *ListViewsParams) (*http.Request,
 	return req, nil
 }
 
-// NewCreateViewRequest generates requests for CreateView
-func NewCreateViewRequest(server string) (*http.Request, error) {
+// NewCreateViewRequest calls the generic CreateView builder with application/json body
+func NewCreateViewRequest(server string, body CreateViewJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateViewRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateViewRequestWithBody generates requests for CreateView with any type of body
+func NewCreateViewRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -33966,11 +34206,13 @@ func NewCreateViewRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
@@ -34215,8 +34457,19 @@ func NewBulkDeleteViewsRequest(server string, params *BulkDeleteViewsParams) (*h
 	return req, nil
 }
 
-// NewPreviewViewsRequest generates requests for PreviewViews
-func NewPreviewViewsRequest(server string) (*http.Request, error) {
+// NewPreviewViewsRequest calls the generic PreviewViews builder with application/json body
+func NewPreviewViewsRequest(server string, body PreviewViewsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPreviewViewsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPreviewViewsRequestWithBody generates requests for PreviewViews with any type of body
+func NewPreviewViewsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -34234,16 +34487,29 @@ func NewPreviewViewsRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewPreviewCountRequest generates requests for PreviewCount
-func NewPreviewCountRequest(server string) (*http.Request, error) {
+// NewPreviewCountRequest calls the generic PreviewCount builder with application/json body
+func NewPreviewCountRequest(server string, body PreviewCountJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPreviewCountRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPreviewCountRequestWithBody generates requests for PreviewCount with any type of body
+func NewPreviewCountRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -34261,11 +34527,13 @@ func NewPreviewCountRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
@@ -34566,8 +34834,19 @@ func NewShowViewRequest(server string, viewId ViewId) (*http.Request, error) {
 	return req, nil
 }
 
-// NewUpdateViewRequest generates requests for UpdateView
-func NewUpdateViewRequest(server string, viewId ViewId) (*http.Request, error) {
+// NewUpdateViewRequest calls the generic UpdateView builder with application/json body
+func NewUpdateViewRequest(server string, viewId ViewId, body UpdateViewJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateViewRequestWithBody(server, viewId, "application/json", bodyReader)
+}
+
+// NewUpdateViewRequestWithBody generates requests for UpdateView with any type of body
+func NewUpdateViewRequestWithBody(server string, viewId ViewId, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -34592,11 +34871,13 @@ func NewUpdateViewRequest(server string, viewId ViewId) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
@@ -34801,6 +35082,22 @@ func NewListTicketsFromViewRequest(server string, viewId ViewId, params *ListTic
 
 		}
 
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page[size]", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
@@ -36018,8 +36315,10 @@ type ClientWithResponsesInterface interface {
 	// RetrieveSLAPolicyFilterDefinitionItemsWithResponse request
 	RetrieveSLAPolicyFilterDefinitionItemsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*RetrieveSLAPolicyFilterDefinitionItemsWrap, error)
 
-	// ReorderSLAPoliciesWithResponse request
-	ReorderSLAPoliciesWithResponse(ctx context.Context, params *ReorderSLAPoliciesParams, reqEditors ...RequestEditorFn) (*ReorderSLAPoliciesWrap, error)
+	// ReorderSLAPoliciesWithBodyWithResponse request with any body
+	ReorderSLAPoliciesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReorderSLAPoliciesWrap, error)
+
+	ReorderSLAPoliciesWithResponse(ctx context.Context, body ReorderSLAPoliciesJSONRequestBody, reqEditors ...RequestEditorFn) (*ReorderSLAPoliciesWrap, error)
 
 	// DeleteSLAPolicyWithResponse request
 	DeleteSLAPolicyWithResponse(ctx context.Context, slaPolicyId SLAPolicyId, reqEditors ...RequestEditorFn) (*DeleteSLAPolicyWrap, error)
@@ -36292,8 +36591,10 @@ type ClientWithResponsesInterface interface {
 	// DeleteManyTriggersWithResponse request
 	DeleteManyTriggersWithResponse(ctx context.Context, params *DeleteManyTriggersParams, reqEditors ...RequestEditorFn) (*DeleteManyTriggersWrap, error)
 
-	// ReorderTriggersWithResponse request
-	ReorderTriggersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ReorderTriggersWrap, error)
+	// ReorderTriggersWithBodyWithResponse request with any body
+	ReorderTriggersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReorderTriggersWrap, error)
+
+	ReorderTriggersWithResponse(ctx context.Context, body ReorderTriggersJSONRequestBody, reqEditors ...RequestEditorFn) (*ReorderTriggersWrap, error)
 
 	// SearchTriggersWithResponse request
 	SearchTriggersWithResponse(ctx context.Context, params *SearchTriggersParams, reqEditors ...RequestEditorFn) (*SearchTriggersWrap, error)
@@ -36501,8 +36802,10 @@ type ClientWithResponsesInterface interface {
 	// ListViewsWithResponse request
 	ListViewsWithResponse(ctx context.Context, params *ListViewsParams, reqEditors ...RequestEditorFn) (*ListViewsWrap, error)
 
-	// CreateViewWithResponse request
-	CreateViewWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CreateViewWrap, error)
+	// CreateViewWithBodyWithResponse request with any body
+	CreateViewWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateViewWrap, error)
+
+	CreateViewWithResponse(ctx context.Context, body CreateViewJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateViewWrap, error)
 
 	// ListActiveViewsWithResponse request
 	ListActiveViewsWithResponse(ctx context.Context, params *ListActiveViewsParams, reqEditors ...RequestEditorFn) (*ListActiveViewsWrap, error)
@@ -36519,11 +36822,15 @@ type ClientWithResponsesInterface interface {
 	// BulkDeleteViewsWithResponse request
 	BulkDeleteViewsWithResponse(ctx context.Context, params *BulkDeleteViewsParams, reqEditors ...RequestEditorFn) (*BulkDeleteViewsWrap, error)
 
-	// PreviewViewsWithResponse request
-	PreviewViewsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PreviewViewsWrap, error)
+	// PreviewViewsWithBodyWithResponse request with any body
+	PreviewViewsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PreviewViewsWrap, error)
+
+	PreviewViewsWithResponse(ctx context.Context, body PreviewViewsJSONRequestBody, reqEditors ...RequestEditorFn) (*PreviewViewsWrap, error)
 
-	// PreviewCountWithResponse request
-	PreviewCountWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PreviewCountWrap, error)
+	// PreviewCountWithBodyWithResponse request with any body
+	PreviewCountWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PreviewCountWrap, error)
+
+	PreviewCountWithResponse(ctx context.Context, body PreviewCountJSONRequestBody, reqEditors ...RequestEditorFn) (*PreviewCountWrap, error)
 
 	// SearchViewsWithResponse request
 	SearchViewsWithResponse(ctx context.Context, params *SearchViewsParams, reqEditors ...RequestEditorFn) (*SearchViewsWrap, error)
@@ -36540,8 +36847,10 @@ type ClientWithResponsesInterface interface {
 	// ShowViewWithResponse request
 	ShowViewWithResponse(ctx context.Context, viewId ViewId, reqEditors ...RequestEditorFn) (*ShowViewWrap, error)
 
-	// UpdateViewWithResponse request
-	UpdateViewWithResponse(ctx context.Context, viewId ViewId, reqEditors ...RequestEditorFn) (*UpdateViewWrap, error)
+	// UpdateViewWithBodyWithResponse request with any body
+	UpdateViewWithBodyWithResponse(ctx context.Context, viewId ViewId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateViewWrap, error)
+
+	UpdateViewWithResponse(ctx context.Context, viewId ViewId, body UpdateViewJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateViewWrap, error)
 
 	// GetViewCountWithResponse request
 	GetViewCountWithResponse(ctx context.Context, viewId ViewId, reqEditors ...RequestEditorFn) (*GetViewCountWrap, error)
@@ -46082,7 +46391,18 @@ func (r ExportViewWrap) StatusCode() int {
 type ListTicketsFromViewWrap struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *TicketsResponse
+	JSON200      *struct {
+		Links *struct {
+			Next *string `json:"next,omitempty"`
+			Prev *string `json:"prev,omitempty"`
+		} `json:"links,omitempty"`
+		Meta *struct {
+			AfterCursor  *string `json:"after_cursor,omitempty"`
+			BeforeCursor *string `json:"before_cursor,omitempty"`
+			HasMore      *bool   `json:"has_more,omitempty"`
+		} `json:"meta,omitempty"`
+		Tickets *[]TicketObject `json:"tickets,omitempty"`
+	}
 }
 
 // Status returns HTTPResponse.Status
@@ -48851,9 +49171,17 @@ func (c *ClientWithResponses) RetrieveSLAPolicyFilterDefinitionItemsWithResponse
 	return ParseRetrieveSLAPolicyFilterDefinitionItemsWrap(rsp)
 }
 
-// ReorderSLAPoliciesWithResponse request returning *ReorderSLAPoliciesWrap
-func (c *ClientWithResponses) ReorderSLAPoliciesWithResponse(ctx context.Context, params *ReorderSLAPoliciesParams, reqEditors ...RequestEditorFn) (*ReorderSLAPoliciesWrap, error) {
-	rsp, err := c.ReorderSLAPolicies(ctx, params, reqEditors...)
+// ReorderSLAPoliciesWithBodyWithResponse request with arbitrary body returning *ReorderSLAPoliciesWrap
+func (c *ClientWithResponses) ReorderSLAPoliciesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReorderSLAPoliciesWrap, error) {
+	rsp, err := c.ReorderSLAPoliciesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReorderSLAPoliciesWrap(rsp)
+}
+
+func (c *ClientWithResponses) ReorderSLAPoliciesWithResponse(ctx context.Context, body ReorderSLAPoliciesJSONRequestBody, reqEditors ...RequestEditorFn) (*ReorderSLAPoliciesWrap, error) {
+	rsp, err := c.ReorderSLAPolicies(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -49689,9 +50017,17 @@ func (c *ClientWithResponses) DeleteManyTriggersWithResponse(ctx context.Context
 	return ParseDeleteManyTriggersWrap(rsp)
 }
 
-// ReorderTriggersWithResponse request returning *ReorderTriggersWrap
-func (c *ClientWithResponses) ReorderTriggersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ReorderTriggersWrap, error) {
-	rsp, err := c.ReorderTriggers(ctx, reqEditors...)
+// ReorderTriggersWithBodyWithResponse request with arbitrary body returning *ReorderTriggersWrap
+func (c *ClientWithResponses) ReorderTriggersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReorderTriggersWrap, error) {
+	rsp, err := c.ReorderTriggersWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReorderTriggersWrap(rsp)
+}
+
+func (c *ClientWithResponses) ReorderTriggersWithResponse(ctx context.Context, body ReorderTriggersJSONRequestBody, reqEditors ...RequestEditorFn) (*ReorderTriggersWrap, error) {
+	rsp, err := c.ReorderTriggers(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -50336,9 +50672,17 @@ func (c *ClientWithResponses) ListViewsWithResponse(ctx context.Context, params
 	return ParseListViewsWrap(rsp)
 }
 
-// CreateViewWithResponse request returning *CreateViewWrap
-func (c *ClientWithResponses) CreateViewWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CreateViewWrap, error) {
-	rsp, err := c.CreateView(ctx, reqEditors...)
+// CreateViewWithBodyWithResponse request with arbitrary body returning *CreateViewWrap
+func (c *ClientWithResponses) CreateViewWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateViewWrap, error) {
+	rsp, err := c.CreateViewWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateViewWrap(rsp)
+}
+
+func (c *ClientWithResponses) CreateViewWithResponse(ctx context.Context, body CreateViewJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateViewWrap, error) {
+	rsp, err := c.CreateView(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -50390,18 +50734,34 @@ func (c *ClientWithResponses) BulkDeleteViewsWithResponse(ctx context.Context, p
 	return ParseBulkDeleteViewsWrap(rsp)
 }
 
-// PreviewViewsWithResponse request returning *PreviewViewsWrap
-func (c *ClientWithResponses) PreviewViewsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PreviewViewsWrap, error) {
-	rsp, err := c.PreviewViews(ctx, reqEditors...)
+// PreviewViewsWithBodyWithResponse request with arbitrary body returning *PreviewViewsWrap
+func (c *ClientWithResponses) PreviewViewsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PreviewViewsWrap, error) {
+	rsp, err := c.PreviewViewsWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
 	return ParsePreviewViewsWrap(rsp)
 }
 
-// PreviewCountWithResponse request returning *PreviewCountWrap
-func (c *ClientWithResponses) PreviewCountWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PreviewCountWrap, error) {
-	rsp, err := c.PreviewCount(ctx, reqEditors...)
+func (c *ClientWithResponses) PreviewViewsWithResponse(ctx context.Context, body PreviewViewsJSONRequestBody, reqEditors ...RequestEditorFn) (*PreviewViewsWrap, error) {
+	rsp, err := c.PreviewViews(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePreviewViewsWrap(rsp)
+}
+
+// PreviewCountWithBodyWithResponse request with arbitrary body returning *PreviewCountWrap
+func (c *ClientWithResponses) PreviewCountWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PreviewCountWrap, error) {
+	rsp, err := c.PreviewCountWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePreviewCountWrap(rsp)
+}
+
+func (c *ClientWithResponses) PreviewCountWithResponse(ctx context.Context, body PreviewCountJSONRequestBody, reqEditors ...RequestEditorFn) (*PreviewCountWrap, error) {
+	rsp, err := c.PreviewCount(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -50453,9 +50813,17 @@ func (c *ClientWithResponses) ShowViewWithResponse(ctx context.Context, viewId V
 	return ParseShowViewWrap(rsp)
 }
 
-// UpdateViewWithResponse request returning *UpdateViewWrap
-func (c *ClientWithResponses) UpdateViewWithResponse(ctx context.Context, viewId ViewId, reqEditors ...RequestEditorFn) (*UpdateViewWrap, error) {
-	rsp, err := c.UpdateView(ctx, viewId, reqEditors...)
+// UpdateViewWithBodyWithResponse request with arbitrary body returning *UpdateViewWrap
+func (c *ClientWithResponses) UpdateViewWithBodyWithResponse(ctx context.Context, viewId ViewId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateViewWrap, error) {
+	rsp, err := c.UpdateViewWithBody(ctx, viewId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateViewWrap(rsp)
+}
+
+func (c *ClientWithResponses) UpdateViewWithResponse(ctx context.Context, viewId ViewId, body UpdateViewJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateViewWrap, error) {
+	rsp, err := c.UpdateView(ctx, viewId, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -61538,7 +61906,18 @@ func ParseListTicketsFromViewWrap(rsp *http.Response) (*ListTicketsFromViewWrap,
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TicketsResponse
+		var dest struct {
+			Links *struct {
+				Next *string `json:"next,omitempty"`
+				Prev *string `json:"prev,omitempty"`
+			} `json:"links,omitempty"`
+			Meta *struct {
+				AfterCursor  *string `json:"after_cursor,omitempty"`
+				BeforeCursor *string `json:"before_cursor,omitempty"`
+				HasMore      *bool   `json:"has_more,omitempty"`
+			} `json:"meta,omitempty"`
+			Tickets *[]TicketObject `json:"tickets,omitempty"`
+		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
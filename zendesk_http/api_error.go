@@ -0,0 +1,149 @@
+package zendesk_http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+const requestIdHeader = "X-Zendesk-Request-Id"
+
+// APIError is an error answer of the Zendesk API. It decodes the envelope {error, description, details} of the
+// Support API, as well as the list {errors: [...]} of newer APIs like the Webhook API.
+// https://developer.zendesk.com/api-reference/introduction/requests/#response-format
+type APIError struct {
+	StatusCode int
+	// RequestId identifies the request towards Zendesk support, from the X-Zendesk-Request-Id header.
+	RequestId   string
+	Title       string
+	Description string
+	// FieldErrors are the validation errors of single fields of the request.
+	FieldErrors []FieldError
+	Body        string
+}
+
+// FieldError is the validation error of a field, like agent_label in the Support API or webhook.endpoint in the
+// Webhook API. Nested fields are separated by dots.
+type FieldError struct {
+	Field       string
+	Code        string
+	Description string
+}
+
+type errorEnvelope struct {
+	Error       json.RawMessage `json:"error"`
+	Description string          `json:"description"`
+	Details     map[string][]struct {
+		Description string `json:"description"`
+		Error       string `json:"error"`
+	} `json:"details"`
+	Errors []struct {
+		Code   string `json:"code"`
+		Title  string `json:"title"`
+		Detail string `json:"detail"`
+		Source *struct {
+			Pointer   string `json:"pointer"`
+			Parameter string `json:"parameter"`
+		} `json:"source"`
+	} `json:"errors"`
+}
+
+// NewAPIError decodes the error answer of the Zendesk API. A body that is no error envelope is kept as description.
+func NewAPIError(response *http.Response, body []byte) *APIError {
+	apiError := &APIError{Body: string(body)}
+	if response != nil {
+		apiError.StatusCode = response.StatusCode
+		apiError.RequestId = response.Header.Get(requestIdHeader)
+	}
+
+	var envelope errorEnvelope
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		apiError.Title = http.StatusText(apiError.StatusCode)
+		apiError.Description = strings.TrimSpace(string(body))
+		return apiError
+	}
+
+	apiError.Title, apiError.Description = decodeErrorTitle(envelope.Error, envelope.Description)
+
+	fields := make([]string, 0, len(envelope.Details))
+	for field := range envelope.Details {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	for _, field := range fields {
+		for _, detail := range envelope.Details[field] {
+			apiError.FieldErrors = append(apiError.FieldErrors, FieldError{Field: field, Code: detail.Error, Description: detail.Description})
+		}
+	}
+
+	var descriptions []string
+	for _, listedError := range envelope.Errors {
+		description := listedError.Detail
+		if description == "" {
+			description = listedError.Title
+		}
+		if listedError.Source != nil && (listedError.Source.Pointer != "" || listedError.Source.Parameter != "") {
+			field := listedError.Source.Parameter
+			if listedError.Source.Pointer != "" {
+				field = strings.ReplaceAll(strings.TrimPrefix(listedError.Source.Pointer, "/"), "/", ".")
+			}
+			apiError.FieldErrors = append(apiError.FieldErrors, FieldError{Field: field, Code: listedError.Code, Description: description})
+			continue
+		}
+		if apiError.Title == "" {
+			apiError.Title = listedError.Code
+		}
+		descriptions = append(descriptions, description)
+	}
+	if apiError.Description == "" {
+		apiError.Description = strings.Join(descriptions, "; ")
+	}
+
+	if apiError.Title == "" {
+		apiError.Title = http.StatusText(apiError.StatusCode)
+	}
+	return apiError
+}
+
+// decodeErrorTitle reads the error member, which is either a code like "RecordInvalid" or an object with a title
+// and a message.
+func decodeErrorTitle(rawError json.RawMessage, description string) (string, string) {
+	if len(rawError) == 0 {
+		return "", description
+	}
+	var code string
+	if err := json.Unmarshal(rawError, &code); err == nil {
+		return code, description
+	}
+	var titled struct {
+		Title   string `json:"title"`
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(rawError, &titled); err == nil {
+		if description == "" {
+			description = titled.Message
+		}
+		return titled.Title, description
+	}
+	return "", description
+}
+
+func (e *APIError) Error() string {
+	var message strings.Builder
+	fmt.Fprintf(&message, "Zendesk answered with the status %d", e.StatusCode)
+	if e.Title != "" {
+		fmt.Fprintf(&message, " %s", e.Title)
+	}
+	if e.Description != "" {
+		fmt.Fprintf(&message, ": %s", e.Description)
+	}
+	for _, fieldError := range e.FieldErrors {
+		fmt.Fprintf(&message, "\n%s: %s", fieldError.Field, fieldError.Description)
+	}
+	if e.RequestId != "" {
+		fmt.Fprintf(&message, "\nZendesk request id: %s", e.RequestId)
+	}
+	return message.String()
+}
@@ -0,0 +1,60 @@
+package zendesk_http
+
+import (
+	"net/http"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestNewAPIError(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		body      string
+		want      APIError
+		wantError string
+	}{
+		{name: "support API validation errors",
+			status: http.StatusUnprocessableEntity,
+			body: `{"error":"RecordInvalid","description":"Record validation errors","details":{
+				"end_user_label":[{"description":"End user label: cannot be blank","error":"BlankValue"}],
+				"agent_label":[{"description":"Agent label: is too long","error":"InvalidValue"}]}}`,
+			want: APIError{StatusCode: 422, RequestId: "req-1", Title: "RecordInvalid", Description: "Record validation errors",
+				FieldErrors: []FieldError{
+					{Field: "agent_label", Code: "InvalidValue", Description: "Agent label: is too long"},
+					{Field: "end_user_label", Code: "BlankValue", Description: "End user label: cannot be blank"},
+				}},
+			wantError: "Zendesk answered with the status 422 RecordInvalid: Record validation errors\n" +
+				"agent_label: Agent label: is too long\nend_user_label: End user label: cannot be blank\nZendesk request id: req-1"},
+		{name: "error object",
+			status:    http.StatusForbidden,
+			body:      `{"error":{"title":"Forbidden","message":"You do not have access to this page."}}`,
+			want:      APIError{StatusCode: 403, RequestId: "req-1", Title: "Forbidden", Description: "You do not have access to this page."},
+			wantError: "Zendesk answered with the status 403 Forbidden: You do not have access to this page.\nZendesk request id: req-1"},
+		{name: "webhook API errors",
+			status: http.StatusBadRequest,
+			body: `{"errors":[{"code":"InvalidEndpoint","title":"Invalid endpoint","detail":"Endpoint must be https","source":{"pointer":"/webhook/endpoint"}},
+				{"code":"TooManyWebhooks","title":"Too many webhooks"}]}`,
+			want: APIError{StatusCode: 400, RequestId: "req-1", Title: "TooManyWebhooks", Description: "Too many webhooks",
+				FieldErrors: []FieldError{{Field: "webhook.endpoint", Code: "InvalidEndpoint", Description: "Endpoint must be https"}}},
+			wantError: "Zendesk answered with the status 400 TooManyWebhooks: Too many webhooks\nwebhook.endpoint: Endpoint must be https\nZendesk request id: req-1"},
+		{name: "no JSON",
+			status:    http.StatusBadGateway,
+			body:      "<html>Bad Gateway</html>\n",
+			want:      APIError{StatusCode: 502, RequestId: "req-1", Title: "Bad Gateway", Description: "<html>Bad Gateway</html>"},
+			wantError: "Zendesk answered with the status 502 Bad Gateway: <html>Bad Gateway</html>\nZendesk request id: req-1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response := &http.Response{StatusCode: tt.status, Header: http.Header{}}
+			response.Header.Set("X-Zendesk-Request-Id", "req-1")
+
+			apiError := NewAPIError(response, []byte(tt.body))
+
+			tt.want.Body = tt.body
+			assert.DeepEqual(t, *apiError, tt.want)
+			assert.Error(t, apiError, tt.wantError)
+		})
+	}
+}
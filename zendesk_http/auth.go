@@ -0,0 +1,135 @@
+package zendesk_http
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// Authenticator adds the credentials to a request to the Zendesk API.
+// Its Authenticate method has the signature of the RequestEditorFn of the generated clients,
+// so it can be passed to them with WithRequestEditorFn.
+type Authenticator interface {
+	Authenticate(ctx context.Context, req *http.Request) error
+}
+
+// APITokenAuthenticator authenticates with an agent's email address and an API token.
+// https://developer.zendesk.com/api-reference/introduction/security-and-auth/#api-token
+type APITokenAuthenticator struct {
+	headerValue string
+}
+
+func NewAPITokenAuthenticator(email string, apiToken string) *APITokenAuthenticator {
+	headerToken := email + "/token:" + apiToken
+	return &APITokenAuthenticator{
+		headerValue: "Basic " + base64.StdEncoding.EncodeToString([]byte(headerToken)),
+	}
+}
+
+func (a *APITokenAuthenticator) Authenticate(_ context.Context, req *http.Request) error {
+	req.Header.Set("Authorization", a.headerValue)
+	return nil
+}
+
+// BearerTokenAuthenticator authenticates with an OAuth access token.
+// https://developer.zendesk.com/api-reference/introduction/security-and-auth/#bearer-token
+type BearerTokenAuthenticator struct {
+	headerValue string
+}
+
+func NewBearerTokenAuthenticator(accessToken string) *BearerTokenAuthenticator {
+	return &BearerTokenAuthenticator{headerValue: "Bearer " + accessToken}
+}
+
+func (a *BearerTokenAuthenticator) Authenticate(_ context.Context, req *http.Request) error {
+	req.Header.Set("Authorization", a.headerValue)
+	return nil
+}
+
+type clientCredentialsTokenRequest struct {
+	GrantType    string `json:"grant_type"`
+	ClientId     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+	Scope        string `json:"scope,omitempty"`
+}
+
+type clientCredentialsTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	Scope       string `json:"scope"`
+}
+
+// ExchangeClientCredentials requests an OAuth access token for a confidential OAuth client
+// with the client credentials grant and returns it.
+// https://developer.zendesk.com/api-reference/ticketing/oauth/grant_type_tokens/#client-credentials-grant-type
+func ExchangeClientCredentials(ctx context.Context, httpClient *http.Client, serverUrl string, clientId string, clientSecret string, scope string) (string, error) {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	body, err := json.Marshal(clientCredentialsTokenRequest{
+		GrantType:    "client_credentials",
+		ClientId:     clientId,
+		ClientSecret: clientSecret,
+		Scope:        scope,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	tokenUrl := strings.TrimSuffix(serverUrl, "/") + "/oauth/tokens"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenUrl, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	responseBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("OAuth client credentials exchange failed with status %s: %s", resp.Status, string(responseBody))
+	}
+
+	var token clientCredentialsTokenResponse
+	if err := json.Unmarshal(responseBody, &token); err != nil {
+		return "", fmt.Errorf("decoding OAuth token response: %w", err)
+	}
+	if token.AccessToken == "" {
+		return "", fmt.Errorf("OAuth token response of %s contains no access_token", tokenUrl)
+	}
+	return token.AccessToken, nil
+}
+
+// AuthenticatingTransport is a http.RoundTripper adding the credentials of an Authenticator
+// to every request, for clients which do not support request editors.
+type AuthenticatingTransport struct {
+	Authenticator Authenticator
+	// Base is the underlying transport, http.DefaultTransport when nil.
+	Base http.RoundTripper
+}
+
+func (t *AuthenticatingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// a RoundTripper must not modify the given request
+	authenticatedRequest := req.Clone(req.Context())
+	if err := t.Authenticator.Authenticate(req.Context(), authenticatedRequest); err != nil {
+		return nil, err
+	}
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return base.RoundTrip(authenticatedRequest)
+}
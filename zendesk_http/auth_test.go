@@ -0,0 +1,77 @@
+package zendesk_http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestAuthenticators(t *testing.T) {
+	tests := []struct {
+		name          string
+		authenticator Authenticator
+		want          string
+	}{
+		{name: "api token",
+			authenticator: NewAPITokenAuthenticator("jdoe@example.com", "abc"),
+			// base64 of "jdoe@example.com/token:abc"
+			want: "Basic amRvZUBleGFtcGxlLmNvbS90b2tlbjphYmM="},
+		{name: "bearer token",
+			authenticator: NewBearerTokenAuthenticator("xyz"),
+			want:          "Bearer xyz"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "https://example.zendesk.com/api/v2/users/me", nil)
+			assert.NilError(t, tt.authenticator.Authenticate(context.Background(), req))
+			assert.Equal(t, req.Header.Get("Authorization"), tt.want)
+		})
+	}
+}
+
+func TestExchangeClientCredentials(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.URL.Path, "/oauth/tokens")
+		var request clientCredentialsTokenRequest
+		assert.NilError(t, json.NewDecoder(r.Body).Decode(&request))
+		if request.ClientSecret != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":"invalid_client"}`))
+			return
+		}
+		assert.Equal(t, request.GrantType, "client_credentials")
+		assert.Equal(t, request.Scope, "webhooks:write")
+		_ = json.NewEncoder(w).Encode(clientCredentialsTokenResponse{AccessToken: "access", TokenType: "bearer"})
+	}))
+	defer server.Close()
+
+	token, err := ExchangeClientCredentials(context.Background(), server.Client(), server.URL+"/", "client", "secret", "webhooks:write")
+	assert.NilError(t, err)
+	assert.Equal(t, token, "access")
+
+	_, err = ExchangeClientCredentials(context.Background(), server.Client(), server.URL, "client", "wrong", "webhooks:write")
+	assert.ErrorContains(t, err, "401 Unauthorized")
+}
+
+func TestAuthenticatingTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(r.Header.Get("Authorization")))
+	}))
+	defer server.Close()
+
+	httpClient := &http.Client{Transport: &AuthenticatingTransport{Authenticator: NewBearerTokenAuthenticator("xyz")}}
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	assert.NilError(t, err)
+	resp, err := httpClient.Do(req)
+	assert.NilError(t, err)
+	defer resp.Body.Close()
+
+	var body [32]byte
+	n, _ := resp.Body.Read(body[:])
+	assert.Equal(t, string(body[:n]), "Bearer xyz")
+	assert.Equal(t, req.Header.Get("Authorization"), "", "the original request must not be modified")
+}
@@ -0,0 +1,209 @@
+package zendesk_http
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	redactedValue = "***REDACTED***"
+	// maxLoggedBodyLength truncates bodies in the TRACE log, e.g. of large list responses
+	maxLoggedBodyLength = 64 * 1024
+)
+
+// sensitiveHeaders are masked in the logged request and response headers.
+var sensitiveHeaders = map[string]bool{
+	"Authorization":       true,
+	"Proxy-Authorization": true,
+	"Cookie":              true,
+	"Set-Cookie":          true,
+}
+
+// sensitiveJSONKeys are masked wherever they appear in a logged JSON body. They cover
+// webhook authentication data, signing secrets and OAuth tokens and client secrets.
+var sensitiveJSONKeys = map[string]bool{
+	"password":      true,
+	"token":         true,
+	"secret":        true,
+	"access_token":  true,
+	"refresh_token": true,
+	"client_secret": true,
+	"api_token":     true,
+}
+
+// logLevelEnvVars set the level of the provider logger, the first one set wins. TF_LOG_PROVIDER_ZENDESK is
+// derived from the provider name the same way terraform-plugin-go does.
+var logLevelEnvVars = []string{"TF_LOG_PROVIDER_ZENDESK", "TF_LOG_PROVIDER", "TF_LOG"}
+
+// rateLimitHeaders are added to every log entry when the API sends them.
+var rateLimitHeaders = map[string]string{
+	"X-Rate-Limit":           "rate_limit",
+	"X-Rate-Limit-Remaining": "rate_limit_remaining",
+	"Ratelimit-Reset":        "rate_limit_reset",
+	"Retry-After":            "retry_after",
+	"X-Zendesk-Request-Id":   "zendesk_request_id",
+}
+
+// LoggingTransport is a http.RoundTripper which logs every request to the Zendesk API with
+// its method, path, status, latency and rate limit headers at DEBUG level. At TRACE level the
+// headers and bodies are logged as well, after the secrets in them have been redacted. Bodies are
+// only buffered when TRACE logging is enabled.
+type LoggingTransport struct {
+	// Base is the underlying transport, http.DefaultTransport when nil.
+	Base http.RoundTripper
+}
+
+func (t *LoggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	trace := traceLoggingEnabled()
+	if trace {
+		requestBody, err := peekRequestBody(req)
+		if err != nil {
+			return nil, err
+		}
+		tflog.Trace(ctx, "Sending Zendesk API request", map[string]any{
+			"method":  req.Method,
+			"path":    req.URL.Path,
+			"query":   req.URL.RawQuery,
+			"headers": RedactHeaders(req.Header),
+			"body":    RedactBody(requestBody),
+		})
+	}
+
+	start := time.Now()
+	resp, err := base.RoundTrip(req)
+	fields := map[string]any{
+		"method":     req.Method,
+		"path":       req.URL.Path,
+		"latency_ms": time.Since(start).Milliseconds(),
+	}
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.Debug(ctx, "Zendesk API request failed", fields)
+		return nil, err
+	}
+
+	fields["status"] = resp.StatusCode
+	for header, field := range rateLimitHeaders {
+		if value := resp.Header.Get(header); value != "" {
+			fields[field] = value
+		}
+	}
+	tflog.Debug(ctx, "Zendesk API request completed", fields)
+	if !trace {
+		return resp, nil
+	}
+
+	responseBody, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(responseBody))
+	if err != nil {
+		return nil, err
+	}
+	tflog.Trace(ctx, "Received Zendesk API response", map[string]any{
+		"method":  req.Method,
+		"path":    req.URL.Path,
+		"status":  resp.StatusCode,
+		"headers": RedactHeaders(resp.Header),
+		"body":    RedactBody(responseBody),
+	})
+
+	return resp, nil
+}
+
+// traceLoggingEnabled reports whether the provider logs at TRACE level, the only level the bodies are logged at.
+func traceLoggingEnabled() bool {
+	for _, envVar := range logLevelEnvVars {
+		if level := strings.ToUpper(os.Getenv(envVar)); level != "" {
+			return level == "TRACE" || level == "JSON"
+		}
+	}
+	// the acceptance test framework logs at TRACE level into this file
+	return os.Getenv("TF_ACC_LOG_PATH") != ""
+}
+
+// peekRequestBody reads the request body and puts a copy back into the request.
+func peekRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		defer body.Close()
+		return io.ReadAll(body)
+	}
+	body, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return body, err
+}
+
+// RedactHeaders returns the headers as a map with credentials masked.
+func RedactHeaders(header http.Header) map[string]string {
+	redacted := make(map[string]string, len(header))
+	for key, values := range header {
+		if sensitiveHeaders[http.CanonicalHeaderKey(key)] {
+			redacted[key] = redactedValue
+		} else {
+			redacted[key] = strings.Join(values, ", ")
+		}
+	}
+	return redacted
+}
+
+// RedactBody returns the body for logging with the values of sensitive JSON keys masked.
+// Bodies which are not JSON may contain credentials in any form, only their size is returned.
+func RedactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	var document any
+	if err := json.Unmarshal(body, &document); err != nil {
+		return fmt.Sprintf("<%d bytes of non-JSON body omitted>", len(body))
+	}
+	redacted, err := json.Marshal(redactJSONValue(document))
+	if err != nil {
+		return fmt.Sprintf("<%d bytes of body omitted>", len(body))
+	}
+	if len(redacted) > maxLoggedBodyLength {
+		return string(redacted[:maxLoggedBodyLength]) + "...<truncated>"
+	}
+	return string(redacted)
+}
+
+func redactJSONValue(value any) any {
+	switch typed := value.(type) {
+	case map[string]any:
+		for key, nested := range typed {
+			if sensitiveJSONKeys[strings.ToLower(key)] && nested != nil {
+				typed[key] = redactedValue
+			} else {
+				typed[key] = redactJSONValue(nested)
+			}
+		}
+		return typed
+	case []any:
+		for i, nested := range typed {
+			typed[i] = redactJSONValue(nested)
+		}
+		return typed
+	default:
+		return value
+	}
+}
@@ -0,0 +1,129 @@
+package zendesk_http
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestRedactBody(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{name: "webhook authentication data",
+			body: `{"webhook":{"name":"hook","authentication":{"type":"basic_auth","data":{"username":"u","password":"p"}}}}`,
+			want: `{"webhook":{"authentication":{"data":{"password":"***REDACTED***","username":"u"},"type":"basic_auth"},"name":"hook"}}`},
+		{name: "signing secret",
+			body: `{"signing_secret":{"algorithm":"SHA256","secret":"s3cr3t"}}`,
+			want: `{"signing_secret":{"algorithm":"SHA256","secret":"***REDACTED***"}}`},
+		{name: "oauth token exchange in a list",
+			body: `[{"client_secret":"x","access_token":"y","Token":"z","scope":"read"}]`,
+			want: `[{"Token":"***REDACTED***","access_token":"***REDACTED***","client_secret":"***REDACTED***","scope":"read"}]`},
+		{name: "null values stay null",
+			body: `{"token":null}`,
+			want: `{"token":null}`},
+		{name: "non-JSON body",
+			body: `grant_type=client_credentials&client_secret=x`,
+			want: `<45 bytes of non-JSON body omitted>`},
+		{name: "empty body",
+			body: ``,
+			want: ``},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, RedactBody([]byte(tt.body)), tt.want)
+		})
+	}
+}
+
+func TestRedactHeaders(t *testing.T) {
+	header := http.Header{}
+	header.Set("Authorization", "Basic abc")
+	header.Set("Content-Type", "application/json")
+
+	redacted := RedactHeaders(header)
+	assert.Equal(t, redacted["Authorization"], "***REDACTED***")
+	assert.Equal(t, redacted["Content-Type"], "application/json")
+}
+
+func TestLoggingTransport_KeepsBodies(t *testing.T) {
+	setLogLevel(t, "TRACE")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		_, _ = w.Write(body)
+	}))
+	defer server.Close()
+
+	httpClient := &http.Client{Transport: &LoggingTransport{}}
+	resp, err := httpClient.Post(server.URL, "application/json", strings.NewReader(`{"token":"t"}`))
+	assert.NilError(t, err)
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	assert.NilError(t, err)
+	assert.Equal(t, string(body), `{"token":"t"}`, "logging must not consume the request or response body")
+}
+
+func TestLoggingTransport_SkipsBodiesBelowTrace(t *testing.T) {
+	setLogLevel(t, "DEBUG")
+	body := io.NopCloser(strings.NewReader(`{"token":"t"}`))
+	base := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: body}, nil
+	})
+
+	req, err := http.NewRequest(http.MethodGet, "http://localhost/api/v2/tickets", nil)
+	assert.NilError(t, err)
+	resp, err := (&LoggingTransport{Base: base}).RoundTrip(req)
+	assert.NilError(t, err)
+	assert.Equal(t, resp.Body, body, "the response body must not be buffered when it is not logged")
+}
+
+func TestTraceLoggingEnabled(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+		want bool
+	}{
+		{name: "not set", env: map[string]string{}, want: false},
+		{name: "TF_LOG trace", env: map[string]string{"TF_LOG": "trace"}, want: true},
+		{name: "TF_LOG json", env: map[string]string{"TF_LOG": "JSON"}, want: true},
+		{name: "TF_LOG debug", env: map[string]string{"TF_LOG": "DEBUG"}, want: false},
+		{name: "provider level wins", env: map[string]string{"TF_LOG": "TRACE", "TF_LOG_PROVIDER": "INFO"}, want: false},
+		{name: "zendesk provider level wins", env: map[string]string{"TF_LOG_PROVIDER": "INFO", "TF_LOG_PROVIDER_ZENDESK": "TRACE"}, want: true},
+		{name: "acceptance test log", env: map[string]string{"TF_ACC_LOG_PATH": "/tmp/acc.log"}, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clearLogLevel(t)
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+			assert.Equal(t, traceLoggingEnabled(), tt.want)
+		})
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func setLogLevel(t *testing.T, level string) {
+	t.Helper()
+	clearLogLevel(t)
+	t.Setenv("TF_LOG", level)
+}
+
+func clearLogLevel(t *testing.T) {
+	t.Helper()
+	for _, envVar := range append(logLevelEnvVars, "TF_ACC_LOG_PATH") {
+		t.Setenv(envVar, "")
+	}
+}
@@ -0,0 +1,90 @@
+package zendesk_http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// PageLinks are the pagination fields of a Zendesk list response. Cursor pagination sets HasMore, Next and
+// AfterCursor, offset pagination sets NextPage.
+// https://developer.zendesk.com/api-reference/introduction/pagination/
+type PageLinks struct {
+	HasMore     *bool
+	Next        *string
+	AfterCursor *string
+	NextPage    *string
+}
+
+// NextPageURL returns the URL of the next page, or an empty string on the last page. A URL made of the query
+// only is returned, when a cursor paginated response has an after cursor but no next link.
+func (l PageLinks) NextPageURL() string {
+	if l.HasMore != nil {
+		switch {
+		case !*l.HasMore:
+			return ""
+		case l.Next != nil && *l.Next != "":
+			return *l.Next
+		case l.AfterCursor != nil && *l.AfterCursor != "":
+			return "?" + url.Values{"page[after]": {*l.AfterCursor}}.Encode()
+		}
+	}
+	if l.NextPage != nil {
+		return *l.NextPage
+	}
+	return ""
+}
+
+// Page is one page of a list response.
+type Page[T any] struct {
+	Items []T
+	Links PageLinks
+}
+
+// Paginate reads the pages of a list one after the other and yields their items, until yield returns false or the
+// last page was read. listPage reads the first page when next is nil, and the page of the next URL otherwise.
+func Paginate[T any](ctx context.Context, listPage func(ctx context.Context, next *url.URL) (Page[T], error), yield func(T) bool) error {
+	var next *url.URL
+	for {
+		page, err := listPage(ctx, next)
+		if err != nil {
+			return err
+		}
+		for _, item := range page.Items {
+			if !yield(item) {
+				return nil
+			}
+		}
+
+		nextPageURL := page.Links.NextPageURL()
+		if nextPageURL == "" {
+			return nil
+		}
+		nextURL, err := url.Parse(nextPageURL)
+		if err != nil {
+			return fmt.Errorf("parsing the URL of the next page %q: %w", nextPageURL, err)
+		}
+		if next != nil && nextURL.String() == next.String() {
+			return fmt.Errorf("the API returned the link to the page %s again", nextPageURL)
+		}
+		next = nextURL
+	}
+}
+
+// FollowPage is a request editor which requests the page of the next URL, by setting the query parameters of the
+// next URL on the request. The request keeps its other query parameters, like filters. A nil next URL keeps the
+// request unchanged.
+func FollowPage(next *url.URL) func(ctx context.Context, req *http.Request) error {
+	return func(ctx context.Context, req *http.Request) error {
+		if next == nil {
+			return nil
+		}
+		query := req.URL.Query()
+		for key, values := range next.Query() {
+			query[key] = values
+		}
+		req.URL.RawQuery = query.Encode()
+		return nil
+	}
+}
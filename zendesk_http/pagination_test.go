@@ -0,0 +1,91 @@
+package zendesk_http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestPageLinks_NextPageURL(t *testing.T) {
+	hasMore, noMore := true, false
+	next, cursor, nextPage := "https://example.zendesk.com/api/v2/webhooks?page%5Bafter%5D=c1", "c1", "https://example.zendesk.com/api/v2/triggers?page=2"
+	tests := []struct {
+		name  string
+		links PageLinks
+		want  string
+	}{
+		{name: "cursor pagination with next link", links: PageLinks{HasMore: &hasMore, Next: &next, AfterCursor: &cursor}, want: next},
+		{name: "cursor pagination without next link", links: PageLinks{HasMore: &hasMore, AfterCursor: &cursor}, want: "?page%5Bafter%5D=c1"},
+		{name: "last cursor page", links: PageLinks{HasMore: &noMore, Next: &next, AfterCursor: &cursor}, want: ""},
+		{name: "offset pagination", links: PageLinks{NextPage: &nextPage}, want: nextPage},
+		{name: "last offset page", links: PageLinks{}, want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.links.NextPageURL(), tt.want)
+		})
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	pages := map[string]Page[int]{
+		"":  {Items: []int{1, 2}, Links: PageLinks{NextPage: stringPointer("https://example.zendesk.com/api/v2/triggers?page=2")}},
+		"2": {Items: []int{3}, Links: PageLinks{NextPage: stringPointer("https://example.zendesk.com/api/v2/triggers?page=3")}},
+		"3": {Items: []int{4, 5}},
+	}
+	listPage := func(ctx context.Context, next *url.URL) (Page[int], error) {
+		if next == nil {
+			return pages[""], nil
+		}
+		return pages[next.Query().Get("page")], nil
+	}
+
+	var all []int
+	assert.NilError(t, Paginate(context.Background(), listPage, func(item int) bool {
+		all = append(all, item)
+		return true
+	}))
+	assert.DeepEqual(t, all, []int{1, 2, 3, 4, 5})
+
+	var firstThree []int
+	assert.NilError(t, Paginate(context.Background(), listPage, func(item int) bool {
+		firstThree = append(firstThree, item)
+		return len(firstThree) < 3
+	}))
+	assert.DeepEqual(t, firstThree, []int{1, 2, 3})
+}
+
+func TestPaginateStops(t *testing.T) {
+	hasMore, cursor := true, "c1"
+	sameCursor := func(ctx context.Context, next *url.URL) (Page[int], error) {
+		return Page[int]{Items: []int{1}, Links: PageLinks{HasMore: &hasMore, AfterCursor: &cursor}}, nil
+	}
+	err := Paginate(context.Background(), sameCursor, func(int) bool { return true })
+	assert.Error(t, err, "the API returned the link to the page ?page%5Bafter%5D=c1 again")
+
+	failing := func(ctx context.Context, next *url.URL) (Page[int], error) {
+		return Page[int]{}, errors.New("boom")
+	}
+	assert.Error(t, Paginate(context.Background(), failing, func(int) bool { return true }), "boom")
+}
+
+func TestFollowPage(t *testing.T) {
+	next, err := url.Parse("https://example.zendesk.com/api/v2/webhooks?page%5Bafter%5D=c1&page%5Bsize%5D=2")
+	assert.NilError(t, err)
+	req, err := http.NewRequest(http.MethodGet, "https://example.zendesk.com/api/v2/webhooks?filter%5Bname_contains%5D=hook&page%5Bsize%5D=10", nil)
+	assert.NilError(t, err)
+
+	assert.NilError(t, FollowPage(next)(context.Background(), req))
+
+	assert.Equal(t, req.URL.Query().Get("filter[name_contains]"), "hook")
+	assert.Equal(t, req.URL.Query().Get("page[after]"), "c1")
+	assert.Equal(t, req.URL.Query().Get("page[size]"), "2")
+}
+
+func stringPointer(value string) *string {
+	return &value
+}
@@ -0,0 +1,88 @@
+package zendesk_http
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// RateLimiter is a token bucket which spreads requests evenly over a minute. The bucket holds
+// up to a tenth of the per minute budget, so short bursts are allowed without exceeding it.
+type RateLimiter struct {
+	mutex     sync.Mutex
+	interval  time.Duration
+	capacity  float64
+	tokens    float64
+	refilled  time.Time
+	requested int64
+}
+
+func NewRateLimiter(requestsPerMinute int64) *RateLimiter {
+	capacity := float64(requestsPerMinute) / 10
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &RateLimiter{
+		interval: time.Minute / time.Duration(requestsPerMinute),
+		capacity: capacity,
+		tokens:   capacity,
+		refilled: time.Now(),
+	}
+}
+
+// Wait blocks until a request may be sent or the context is done.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	wait := l.reserve()
+	if wait > 0 {
+		tflog.Debug(ctx, "Throttling Zendesk API request", map[string]any{"wait": wait.String()})
+	}
+	return sleep(ctx, wait)
+}
+
+// reserve takes a token and returns how long to wait until it is available.
+func (l *RateLimiter) reserve() time.Duration {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	now := time.Now()
+	l.tokens += float64(now.Sub(l.refilled)) / float64(l.interval)
+	if l.tokens > l.capacity {
+		l.tokens = l.capacity
+	}
+	l.refilled = now
+	l.requested++
+
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens * float64(l.interval))
+}
+
+// Requested is the number of requests which went through the limiter.
+func (l *RateLimiter) Requested() int64 {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return l.requested
+}
+
+// RateLimitTransport is a http.RoundTripper which waits for the RateLimiter before every request.
+type RateLimitTransport struct {
+	Limiter *RateLimiter
+	// Base is the underlying transport, http.DefaultTransport when nil.
+	Base http.RoundTripper
+}
+
+func (t *RateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.Limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return base.RoundTrip(req)
+}
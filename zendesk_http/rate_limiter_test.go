@@ -0,0 +1,30 @@
+package zendesk_http
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestRateLimiter(t *testing.T) {
+	// 600 requests per minute allow a burst of 60 and one more request every 100ms
+	limiter := NewRateLimiter(600)
+
+	for i := 0; i < 60; i++ {
+		assert.Equal(t, limiter.reserve(), time.Duration(0), "request %d of the burst must not wait", i)
+	}
+	wait := limiter.reserve()
+	assert.Assert(t, wait > 90*time.Millisecond && wait <= 100*time.Millisecond, "got wait %v", wait)
+	assert.Equal(t, limiter.Requested(), int64(61))
+}
+
+func TestRateLimiter_WaitIsCancelled(t *testing.T) {
+	limiter := NewRateLimiter(1)
+	assert.NilError(t, limiter.Wait(context.Background()))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, limiter.Wait(ctx), context.Canceled)
+}
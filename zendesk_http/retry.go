@@ -0,0 +1,213 @@
+package zendesk_http
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	DefaultMaxRetries   = 3
+	MaxRetriesLimit     = 10
+	DefaultRetryMaxWait = 60 * time.Second
+
+	retryBaseWait = time.Second
+	// maxBackoffShift keeps the exponential backoff of late attempts from overflowing time.Duration.
+	maxBackoffShift = 30
+)
+
+// RetryTransport is a http.RoundTripper which retries requests rejected by the Zendesk rate limit
+// or failed with a server error. It waits as long as the Retry-After header asks for, otherwise
+// it backs off exponentially with jitter. Once X-Rate-Limit-Remaining reaches zero, following
+// requests are held back until the rate limit window resets.
+// https://developer.zendesk.com/api-reference/introduction/rate-limits/
+type RetryTransport struct {
+	// MaxRetries is the number of retries after the first attempt, zero disables retries.
+	MaxRetries int
+	// MaxWait caps every single wait, also waits requested by the API.
+	MaxWait time.Duration
+	// Base is the underlying transport, http.DefaultTransport when nil.
+	Base http.RoundTripper
+
+	mutex      sync.Mutex
+	pauseUntil time.Time
+}
+
+func NewRetryTransport(maxRetries int, maxWait time.Duration, base http.RoundTripper) *RetryTransport {
+	return &RetryTransport{MaxRetries: maxRetries, MaxWait: maxWait, Base: base}
+}
+
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	for attempt := 0; ; attempt++ {
+		if err := sleep(ctx, time.Until(t.getPauseUntil())); err != nil {
+			return nil, err
+		}
+
+		attemptRequest := req
+		if attempt > 0 {
+			var err error
+			attemptRequest, err = rewindRequest(req)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err := base.RoundTrip(attemptRequest)
+		if err == nil {
+			t.recordRateLimit(resp)
+		}
+
+		if attempt >= t.MaxRetries || !isRetryable(req, resp, err) {
+			return resp, err
+		}
+
+		wait := t.backoff(attempt, resp)
+		fields := map[string]any{"method": req.Method, "path": req.URL.Path, "attempt": attempt + 1, "wait": wait.String()}
+		if err != nil {
+			fields["error"] = err.Error()
+		} else {
+			fields["status"] = resp.StatusCode
+			// the body of the discarded response is never read
+			_ = resp.Body.Close()
+		}
+		tflog.Warn(ctx, "Retrying Zendesk API request", fields)
+
+		if err := sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// isRetryable decides whether a request may be sent again. Rate limited requests were not processed
+// by Zendesk, so they are always retried. Server and network errors are only retried for
+// idempotent methods, as the request may have been applied.
+func isRetryable(req *http.Request, resp *http.Response, err error) bool {
+	if req.Body != nil && req.GetBody == nil {
+		return false
+	}
+	if err != nil {
+		return req.Context().Err() == nil && isIdempotent(req.Method)
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return isIdempotent(req.Method)
+	default:
+		return false
+	}
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// backoff is the Retry-After of the response if given, otherwise a full jitter exponential
+// backoff, both limited to MaxWait.
+func (t *RetryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	wait, ok := time.Duration(0), false
+	if resp != nil {
+		wait, ok = parseRetryAfter(resp.Header.Get("Retry-After"))
+	}
+	if !ok {
+		maxBackoff := retryBaseWait << min(attempt, maxBackoffShift)
+		if t.MaxWait > 0 && maxBackoff > t.MaxWait {
+			maxBackoff = t.MaxWait
+		}
+		wait = time.Duration(rand.Int63n(int64(maxBackoff))) + retryBaseWait/2
+	}
+	if t.MaxWait > 0 && wait > t.MaxWait {
+		wait = t.MaxWait
+	}
+	return wait
+}
+
+// recordRateLimit pauses the following requests when the rate limit of the current window is used up.
+func (t *RetryTransport) recordRateLimit(resp *http.Response) {
+	if resp.Header.Get("X-Rate-Limit-Remaining") != "0" {
+		return
+	}
+	wait, ok := parseRetryAfter(resp.Header.Get("Retry-After"))
+	if !ok {
+		wait, ok = parseRetryAfter(resp.Header.Get("Ratelimit-Reset"))
+	}
+	if !ok {
+		wait = retryBaseWait
+	}
+	if t.MaxWait > 0 && wait > t.MaxWait {
+		wait = t.MaxWait
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if pauseUntil := time.Now().Add(wait); pauseUntil.After(t.pauseUntil) {
+		t.pauseUntil = pauseUntil
+	}
+}
+
+func (t *RetryTransport) getPauseUntil() time.Time {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.pauseUntil
+}
+
+// parseRetryAfter reads a header holding either delay seconds or an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
+
+// rewindRequest clones the request with a fresh body for another attempt.
+func rewindRequest(req *http.Request) (*http.Request, error) {
+	attemptRequest := req.Clone(req.Context())
+	if req.Body != nil && req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		attemptRequest.Body = body
+	}
+	return attemptRequest, nil
+}
+
+func sleep(ctx context.Context, duration time.Duration) error {
+	if duration <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,116 @@
+package zendesk_http
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+// statusSequenceServer answers with the given status codes in order and 200 afterwards.
+func statusSequenceServer(t *testing.T, header http.Header, statusCodes ...int) (*httptest.Server, *int32) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := int(atomic.AddInt32(&calls, 1))
+		body, _ := io.ReadAll(r.Body)
+		for key, values := range header {
+			w.Header()[key] = values
+		}
+		if call <= len(statusCodes) {
+			w.WriteHeader(statusCodes[call-1])
+		}
+		_, _ = w.Write(body)
+	}))
+	t.Cleanup(server.Close)
+	return server, &calls
+}
+
+func TestRetryTransport(t *testing.T) {
+	tests := []struct {
+		name        string
+		method      string
+		header      http.Header
+		statusCodes []int
+		maxRetries  int
+		wantStatus  int
+		wantCalls   int32
+	}{
+		{name: "rate limited request is retried after Retry-After",
+			method: http.MethodGet, header: http.Header{"Retry-After": {"0"}},
+			statusCodes: []int{429, 429}, maxRetries: 3, wantStatus: 200, wantCalls: 3},
+		{name: "rate limited POST is retried",
+			method: http.MethodPost, header: http.Header{"Retry-After": {"0"}},
+			statusCodes: []int{429}, maxRetries: 3, wantStatus: 200, wantCalls: 2},
+		{name: "server error of an idempotent request is retried",
+			method: http.MethodPut, header: http.Header{"Retry-After": {"0"}},
+			statusCodes: []int{503}, maxRetries: 3, wantStatus: 200, wantCalls: 2},
+		{name: "server error of a POST is not retried",
+			method: http.MethodPost, header: http.Header{"Retry-After": {"0"}},
+			statusCodes: []int{503}, maxRetries: 3, wantStatus: 503, wantCalls: 1},
+		{name: "client error is not retried",
+			method: http.MethodGet, statusCodes: []int{404}, maxRetries: 3, wantStatus: 404, wantCalls: 1},
+		{name: "retries are limited",
+			method: http.MethodGet, header: http.Header{"Retry-After": {"0"}},
+			statusCodes: []int{429, 429, 429}, maxRetries: 1, wantStatus: 429, wantCalls: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, calls := statusSequenceServer(t, tt.header, tt.statusCodes...)
+			httpClient := &http.Client{Transport: NewRetryTransport(tt.maxRetries, time.Second, nil)}
+
+			req, err := http.NewRequest(tt.method, server.URL, strings.NewReader(`{"webhook":{}}`))
+			assert.NilError(t, err)
+			resp, err := httpClient.Do(req)
+			assert.NilError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, resp.StatusCode, tt.wantStatus)
+			assert.Equal(t, atomic.LoadInt32(calls), tt.wantCalls)
+			body, _ := io.ReadAll(resp.Body)
+			assert.Equal(t, string(body), `{"webhook":{}}`, "the request body must be resent on every attempt")
+		})
+	}
+}
+
+func TestRetryTransport_PausesWhenRateLimitIsUsedUp(t *testing.T) {
+	server, _ := statusSequenceServer(t, http.Header{"X-Rate-Limit-Remaining": {"0"}, "Ratelimit-Reset": {"5"}})
+	transport := NewRetryTransport(0, 200*time.Millisecond, nil)
+	httpClient := &http.Client{Transport: transport}
+
+	start := time.Now()
+	for i := 0; i < 2; i++ {
+		resp, err := httpClient.Get(server.URL)
+		assert.NilError(t, err)
+		_ = resp.Body.Close()
+	}
+	assert.Assert(t, time.Since(start) >= 200*time.Millisecond, "the second request must wait for the capped reset")
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	wait, ok := parseRetryAfter("7")
+	assert.Assert(t, ok)
+	assert.Equal(t, wait, 7*time.Second)
+
+	wait, ok = parseRetryAfter(time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat))
+	assert.Assert(t, ok)
+	assert.Equal(t, wait, time.Duration(0))
+
+	_, ok = parseRetryAfter("soon")
+	assert.Assert(t, !ok)
+}
+
+func TestRetryTransport_BackoffOfLateAttempts(t *testing.T) {
+	transport := NewRetryTransport(MaxRetriesLimit, DefaultRetryMaxWait, nil)
+	for _, attempt := range []int{0, 33, 34, 64, 1000} {
+		wait := transport.backoff(attempt, nil)
+		assert.Assert(t, wait > 0 && wait <= DefaultRetryMaxWait, "attempt %d waits %s", attempt, wait)
+	}
+
+	uncapped := NewRetryTransport(MaxRetriesLimit, 0, nil)
+	assert.Assert(t, uncapped.backoff(1000, nil) > 0)
+}
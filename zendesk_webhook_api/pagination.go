@@ -0,0 +1,43 @@
+package zendesk_webhook_api
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"terraform-provider-zendesk/zendesk_http"
+)
+
+// Paginate reads the pages of a list and yields their items, until yield returns false or the last page was read.
+// listPage requests one page with the given request editors and returns its items and pagination links.
+func Paginate[T any](ctx context.Context, listPage func(ctx context.Context, reqEditors ...RequestEditorFn) (zendesk_http.Page[T], error), yield func(T) bool) error {
+	return zendesk_http.Paginate(ctx, func(ctx context.Context, next *url.URL) (zendesk_http.Page[T], error) {
+		return listPage(ctx, RequestEditorFn(zendesk_http.FollowPage(next)))
+	}, yield)
+}
+
+// ForEachWebhook yields the webhooks matching the params from all pages, until yield returns false.
+func (s *WebhookApi) ForEachWebhook(ctx context.Context, params *ListWebhooksParams, yield func(WebhookWithoutSensitive) bool) error {
+	return Paginate(ctx, func(ctx context.Context, reqEditors ...RequestEditorFn) (zendesk_http.Page[WebhookWithoutSensitive], error) {
+		var page zendesk_http.Page[WebhookWithoutSensitive]
+		response, err := s.webhookApiClient.ListWebhooksWithResponse(ctx, params, reqEditors...)
+		if err != nil {
+			return page, fmt.Errorf("reading webhooks list from the API: %w", err)
+		}
+		if response.StatusCode() != 200 || response.JSON200 == nil {
+			return page, fmt.Errorf("reading webhooks list from the API, StatusCode: %v: %s", response.StatusCode(), string(response.Body))
+		}
+
+		if response.JSON200.Webhooks != nil {
+			page.Items = *response.JSON200.Webhooks
+		}
+		if response.JSON200.Meta != nil {
+			page.Links.HasMore = response.JSON200.Meta.HasMore
+			page.Links.AfterCursor = response.JSON200.Meta.AfterCursor
+		}
+		if response.JSON200.Links != nil {
+			page.Links.Next = response.JSON200.Links.Next
+		}
+		return page, nil
+	}, yield)
+}
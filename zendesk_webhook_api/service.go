@@ -1,10 +1,10 @@
 package zendesk_webhook_api
 
 import (
-	"encoding/base64"
-	"log"
+	"fmt"
+	"net/http"
 
-	"github.com/oapi-codegen/oapi-codegen/v2/pkg/securityprovider"
+	"terraform-provider-zendesk/zendesk_http"
 )
 
 type WebhookApi struct {
@@ -12,32 +12,23 @@ type WebhookApi struct {
 }
 
 // serverUrl := "https://...."
-// email := "jdoe@example.com"
+// authenticator := zendesk_http.NewAPITokenAuthenticator("jdoe@example.com", apiToken)
+// httpClient := &http.Client{Transport: zendesk_http.NewRetryTransport(3, time.Minute, nil)}
 
-func NewWebhookApi(serverUrl string, email string, apiKey string) *WebhookApi {
-	// https://developer.zendesk.com/api-reference/introduction/security-and-auth/#api-token
-
-	headerToken := email + "/token:" + apiKey
-	headerTokenBase64 := base64.StdEncoding.EncodeToString([]byte(headerToken))
-	headerValue := "Basic " + headerTokenBase64
-
-	securityProviderApiKey, err := securityprovider.NewSecurityProviderApiKey(
-		"header",
-		"Authorization",
-		headerValue)
-
-	if err != nil {
-		log.Fatal(err)
+func NewWebhookApi(serverUrl string, authenticator zendesk_http.Authenticator, httpClient *http.Client) (*WebhookApi, error) {
+	options := []ClientOption{WithRequestEditorFn(authenticator.Authenticate)}
+	if httpClient != nil {
+		options = append(options, WithHTTPClient(httpClient))
 	}
 
-	client, err := NewClientWithResponses(serverUrl, WithRequestEditorFn(securityProviderApiKey.Intercept))
+	client, err := NewClientWithResponses(serverUrl, options...)
 	if err != nil {
-		log.Fatal(err)
+		return nil, fmt.Errorf("creating Zendesk API client for %s: %w", serverUrl, err)
 	}
 
 	return &WebhookApi{
 		webhookApiClient: client,
-	}
+	}, nil
 }
 
 func (s *WebhookApi) GetClient() *ClientWithResponses {
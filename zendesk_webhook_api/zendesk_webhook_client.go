@@ -1253,7 +1253,7 @@ type ListWebhooksWrap struct {
 		Meta *struct {
 			AfterCursor  *string `json:"after_cursor,omitempty"`
 			BeforeCursor *string `json:"before_cursor,omitempty"`
-			HasMore      *string `json:"has_more,omitempty"`
+			HasMore      *bool   `json:"has_more,omitempty"`
 		} `json:"meta,omitempty"`
 		Webhooks *[]WebhookWithoutSensitive `json:"webhooks,omitempty"`
 	}
@@ -1367,7 +1367,7 @@ type TestWebhookWrap struct {
 				Value *string `json:"value,omitempty"`
 			} `json:"headers,omitempty"`
 			Payload *string `json:"payload,omitempty"`
-			Status  *string `json:"status,omitempty"`
+			Status  *int    `json:"status,omitempty"`
 		} `json:"response,omitempty"`
 	}
 	JSON400 *struct {
@@ -1588,10 +1588,11 @@ type ListWebhookInvocationsWrap struct {
 	HTTPResponse *http.Response
 	JSON200      *struct {
 		Invocations *[]struct {
+			CreatedAt         *string `json:"created_at,omitempty"`
 			Id                *string `json:"id,omitempty"`
 			LatestCompletedAt *string `json:"latest_completed_at,omitempty"`
 			Status            *string `json:"status,omitempty"`
-			StatusCode        *string `json:"status_code,omitempty"`
+			StatusCode        *int    `json:"status_code,omitempty"`
 		} `json:"invocations,omitempty"`
 		Links *struct {
 			Next *string `json:"next,omitempty"`
@@ -1600,7 +1601,7 @@ type ListWebhookInvocationsWrap struct {
 		Meta *struct {
 			AfterCursor  *string `json:"after_cursor,omitempty"`
 			BeforeCursor *string `json:"before_cursor,omitempty"`
-			HasMore      *string `json:"has_more,omitempty"`
+			HasMore      *bool   `json:"has_more,omitempty"`
 		} `json:"meta,omitempty"`
 	}
 }
@@ -1634,29 +1635,17 @@ type ListWebhookInvocationAttemptsWrap struct {
 					Key   *string `json:"key,omitempty"`
 					Value *string `json:"value,omitempty"`
 				} `json:"headers,omitempty"`
-				Payload *struct {
-					Dolorcf    *string `json:"dolorcf,omitempty"`
-					Euf        *string `json:"euf,omitempty"`
-					Ex5        *string `json:"ex_5,omitempty"`
-					In9f2      *string `json:"in9f2,omitempty"`
-					Ullamcoce8 *string `json:"ullamcoce8,omitempty"`
-					Ut1f       *string `json:"ut_1f,omitempty"`
-				} `json:"payload,omitempty"`
+				Payload *string `json:"payload,omitempty"`
 			} `json:"request,omitempty"`
 			Response *struct {
 				Headers *[]struct {
 					Key   *string `json:"key,omitempty"`
 					Value *string `json:"value,omitempty"`
 				} `json:"headers,omitempty"`
-				Payload *struct {
-					Aliqua46   *string `json:"aliqua_46,omitempty"`
-					Cupidatat2 *string `json:"cupidatat_2,omitempty"`
-					DoloreB2   *string `json:"dolore_b2,omitempty"`
-					Tempor0b5  *string `json:"tempor_0b5,omitempty"`
-				} `json:"payload,omitempty"`
+				Payload *string `json:"payload,omitempty"`
 			} `json:"response,omitempty"`
 			Status     *string `json:"status,omitempty"`
-			StatusCode *string `json:"status_code,omitempty"`
+			StatusCode *int    `json:"status_code,omitempty"`
 		} `json:"attempts,omitempty"`
 	}
 }
@@ -1933,7 +1922,7 @@ func ParseListWebhooksWrap(rsp *http.Response) (*ListWebhooksWrap, error) {
 			Meta *struct {
 				AfterCursor  *string `json:"after_cursor,omitempty"`
 				BeforeCursor *string `json:"before_cursor,omitempty"`
-				HasMore      *string `json:"has_more,omitempty"`
+				HasMore      *bool   `json:"has_more,omitempty"`
 			} `json:"meta,omitempty"`
 			Webhooks *[]WebhookWithoutSensitive `json:"webhooks,omitempty"`
 		}
@@ -2033,7 +2022,7 @@ func ParseTestWebhookWrap(rsp *http.Response) (*TestWebhookWrap, error) {
 					Value *string `json:"value,omitempty"`
 				} `json:"headers,omitempty"`
 				Payload *string `json:"payload,omitempty"`
-				Status  *string `json:"status,omitempty"`
+				Status  *int    `json:"status,omitempty"`
 			} `json:"response,omitempty"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -2310,10 +2299,11 @@ func ParseListWebhookInvocationsWrap(rsp *http.Response) (*ListWebhookInvocation
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest struct {
 			Invocations *[]struct {
+				CreatedAt         *string `json:"created_at,omitempty"`
 				Id                *string `json:"id,omitempty"`
 				LatestCompletedAt *string `json:"latest_completed_at,omitempty"`
 				Status            *string `json:"status,omitempty"`
-				StatusCode        *string `json:"status_code,omitempty"`
+				StatusCode        *int    `json:"status_code,omitempty"`
 			} `json:"invocations,omitempty"`
 			Links *struct {
 				Next *string `json:"next,omitempty"`
@@ -2322,7 +2312,7 @@ func ParseListWebhookInvocationsWrap(rsp *http.Response) (*ListWebhookInvocation
 			Meta *struct {
 				AfterCursor  *string `json:"after_cursor,omitempty"`
 				BeforeCursor *string `json:"before_cursor,omitempty"`
-				HasMore      *string `json:"has_more,omitempty"`
+				HasMore      *bool   `json:"has_more,omitempty"`
 			} `json:"meta,omitempty"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -2360,29 +2350,17 @@ func ParseListWebhookInvocationAttemptsWrap(rsp *http.Response) (*ListWebhookInv
 						Key   *string `json:"key,omitempty"`
 						Value *string `json:"value,omitempty"`
 					} `json:"headers,omitempty"`
-					Payload *struct {
-						Dolorcf    *string `json:"dolorcf,omitempty"`
-						Euf        *string `json:"euf,omitempty"`
-						Ex5        *string `json:"ex_5,omitempty"`
-						In9f2      *string `json:"in9f2,omitempty"`
-						Ullamcoce8 *string `json:"ullamcoce8,omitempty"`
-						Ut1f       *string `json:"ut_1f,omitempty"`
-					} `json:"payload,omitempty"`
+					Payload *string `json:"payload,omitempty"`
 				} `json:"request,omitempty"`
 				Response *struct {
 					Headers *[]struct {
 						Key   *string `json:"key,omitempty"`
 						Value *string `json:"value,omitempty"`
 					} `json:"headers,omitempty"`
-					Payload *struct {
-						Aliqua46   *string `json:"aliqua_46,omitempty"`
-						Cupidatat2 *string `json:"cupidatat_2,omitempty"`
-						DoloreB2   *string `json:"dolore_b2,omitempty"`
-						Tempor0b5  *string `json:"tempor_0b5,omitempty"`
-					} `json:"payload,omitempty"`
+					Payload *string `json:"payload,omitempty"`
 				} `json:"response,omitempty"`
 				Status     *string `json:"status,omitempty"`
-				StatusCode *string `json:"status_code,omitempty"`
+				StatusCode *int    `json:"status_code,omitempty"`
 			} `json:"attempts,omitempty"`
 		}
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {